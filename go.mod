@@ -1,5 +1,3 @@
 module cryptopals
 
 go 1.14
-
-require github.com/brsmsn/cryptopals v0.0.0-20190402031344-b177b8bf3120
@@ -0,0 +1,342 @@
+//Package convert converts between raw bytes and their hex, base64, base32 and ascii85
+//encodings as streams, so that inputs of any size go through in constant memory.
+//Decoders skip whitespace and report invalid input with its exact byte offset.
+package convert
+
+import (
+	"bytes"
+	"encoding/ascii85"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+//Format is a representation of bytes
+type Format int
+
+const (
+	Raw Format = iota
+	Hex
+	//Base64 is the standard alphabet, padded
+	Base64
+	Base64Raw
+	//Base64URL is the URL and filename safe alphabet, padded
+	Base64URL
+	Base64URLRaw
+	//Base32 is the standard alphabet, padded
+	Base32
+	//Ascii85 is the btoa encoding, without the <~ ~> delimiters
+	Ascii85
+)
+
+var formatNames = []string{"raw", "hex", "base64", "base64-raw", "base64url", "base64url-raw", "base32", "ascii85"}
+
+func (f Format) String() string {
+	if f < 0 || int(f) >= len(formatNames) {
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+	return formatNames[f]
+}
+
+//ParseFormat returns the format with the given name, as String spells it
+func ParseFormat(name string) (Format, error) {
+	for i, n := range formatNames {
+		if n == name {
+			return Format(i), nil
+		}
+	}
+	return 0, fmt.Errorf("convert: unknown format %q", name)
+}
+
+//SyntaxError is invalid input to a decoder. Offset counts the bytes read before the
+//offending one, whitespace included.
+type SyntaxError struct {
+	Format Format
+	Offset int64
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("convert: invalid %v at offset %d: %s", e.Format, e.Offset, e.Msg)
+}
+
+//NewEncoder returns a writer that writes the encoding of what it is given to w in
+//format f. Close flushes the last partial block, it does not close w.
+func NewEncoder(f Format, w io.Writer) (io.WriteCloser, error) {
+	switch f {
+	case Raw:
+		return nopCloser{w}, nil
+	case Hex:
+		return nopCloser{hex.NewEncoder(w)}, nil
+	case Base64, Base64Raw, Base64URL, Base64URLRaw:
+		return base64.NewEncoder(base64Encoding(f), w), nil
+	case Base32:
+		return base32.NewEncoder(base32.StdEncoding, w), nil
+	case Ascii85:
+		return ascii85.NewEncoder(w), nil
+	}
+	return nil, fmt.Errorf("convert: unknown format %v", f)
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func base64Encoding(f Format) *base64.Encoding {
+	switch f {
+	case Base64Raw:
+		return base64.RawStdEncoding
+	case Base64URL:
+		return base64.URLEncoding
+	case Base64URLRaw:
+		return base64.RawURLEncoding
+	}
+	return base64.StdEncoding
+}
+
+//Convert copies src to dst, decoding it from one format and encoding it in the other.
+//It returns the number of decoded bytes.
+func Convert(dst io.Writer, src io.Reader, from, to Format) (int64, error) {
+	dec, err := NewDecoder(from, src)
+	if err != nil {
+		return 0, err
+	}
+	enc, err := NewEncoder(to, dst)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := io.Copy(enc, dec)
+	if err != nil {
+		return n, err
+	}
+	return n, enc.Close()
+}
+
+//chunkSize is how much input a decoder reads at a time
+const chunkSize = 32 << 10
+
+//codec decodes as much of src as it can, like ascii85.Decode: it returns the bytes
+//written to dst and consumed from src, leaving an incomplete block for the next call
+//unless flush is set. Errors are a CorruptInputError indexing src.
+type codec interface {
+	decode(dst, src []byte, flush bool) (ndst, nsrc int, err error)
+	//maxDecodedLen bounds what n bytes of input decode to
+	maxDecodedLen(n int) int
+}
+
+//corruptInputError is the CorruptInputError of the codecs written here
+type corruptInputError int64
+
+func (e corruptInputError) Error() string {
+	return fmt.Sprintf("illegal data at input byte %d", int64(e))
+}
+
+//NewDecoder returns a reader that decodes r from format f
+func NewDecoder(f Format, r io.Reader) (io.Reader, error) {
+	var c codec
+	switch f {
+	case Raw:
+		return r, nil
+	case Hex:
+		c = hexCodec{}
+	case Base64, Base64Raw, Base64URL, Base64URLRaw:
+		c = &blockCodec{enc: base64Encoding(f), size: 4}
+	case Base32:
+		c = &blockCodec{enc: base32.StdEncoding, size: 8}
+	case Ascii85:
+		c = ascii85Codec{}
+	default:
+		return nil, fmt.Errorf("convert: unknown format %v", f)
+	}
+
+	return &decoder{r: r, format: f, codec: c, chunk: make([]byte, chunkSize)}, nil
+}
+
+//decoder strips whitespace from what it reads and keeps the input offset of each
+//remaining byte until it is decoded, so that errors can point at it
+type decoder struct {
+	r      io.Reader
+	format Format
+	codec  codec
+
+	chunk []byte
+	//read is the number of bytes read from r
+	read int64
+	eof  bool
+
+	pending []byte
+	offsets []int64
+
+	out []byte
+	err error
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+//fill reads a chunk and decodes what it can of the pending input
+func (d *decoder) fill() {
+	if !d.eof {
+		n, err := d.r.Read(d.chunk)
+		for i, b := range d.chunk[:n] {
+			switch b {
+			case ' ', '\t', '\r', '\n':
+				continue
+			}
+			d.pending = append(d.pending, b)
+			d.offsets = append(d.offsets, d.read+int64(i))
+		}
+		d.read += int64(n)
+
+		switch {
+		case err == io.EOF:
+			d.eof = true
+		case err != nil:
+			d.err = err
+			return
+		}
+	}
+
+	dst := make([]byte, d.codec.maxDecodedLen(len(d.pending)))
+	ndst, nsrc, err := d.codec.decode(dst, d.pending, d.eof)
+	d.out = dst[:ndst]
+
+	if err != nil {
+		d.err = d.syntaxError(err)
+		return
+	}
+
+	//the consumed input is copied over so the buffers stay the size of a chunk
+	d.pending = d.pending[:copy(d.pending, d.pending[nsrc:])]
+	d.offsets = d.offsets[:copy(d.offsets, d.offsets[nsrc:])]
+	if d.eof {
+		d.err = io.EOF
+	}
+}
+
+//syntaxError positions a CorruptInputError, its index is into the pending input
+func (d *decoder) syntaxError(err error) error {
+	var i int64
+	switch e := err.(type) {
+	case corruptInputError:
+		i = int64(e)
+	case base64.CorruptInputError:
+		i = int64(e)
+	case base32.CorruptInputError:
+		i = int64(e)
+	case ascii85.CorruptInputError:
+		i = int64(e)
+	default:
+		return err
+	}
+
+	if i < int64(len(d.pending)) {
+		return &SyntaxError{Format: d.format, Offset: d.offsets[i], Msg: fmt.Sprintf("unexpected %q", d.pending[i])}
+	}
+	return &SyntaxError{Format: d.format, Offset: d.read, Msg: "input ends in the middle of a block"}
+}
+
+//hexCodec decodes hex digits of either case
+type hexCodec struct{}
+
+func (hexCodec) maxDecodedLen(n int) int { return n / 2 }
+
+func (hexCodec) decode(dst, src []byte, flush bool) (int, int, error) {
+	valid := len(src)
+	for i, b := range src {
+		if _, ok := unhex(b); !ok {
+			valid = i
+			break
+		}
+	}
+
+	n := valid &^ 1
+	for i := 0; i < n; i += 2 {
+		hi, _ := unhex(src[i])
+		lo, _ := unhex(src[i+1])
+		dst[i/2] = hi<<4 | lo
+	}
+
+	switch {
+	case valid < len(src):
+		return n / 2, n, corruptInputError(valid)
+	case flush && n < len(src):
+		return n / 2, n, corruptInputError(len(src))
+	}
+	return n / 2, n, nil
+}
+
+func unhex(b byte) (byte, bool) {
+	switch {
+	case b >= '0' && b <= '9':
+		return b - '0', true
+	case b >= 'a' && b <= 'f':
+		return b - 'a' + 10, true
+	case b >= 'A' && b <= 'F':
+		return b - 'A' + 10, true
+	}
+	return 0, false
+}
+
+//blockCodec decodes base64 and base32 a whole number of blocks at a time. A padded
+//block ends the input.
+type blockCodec struct {
+	enc interface {
+		Decode(dst, src []byte) (int, error)
+		DecodedLen(n int) int
+	}
+	size   int
+	padded bool
+}
+
+func (c *blockCodec) maxDecodedLen(n int) int {
+	//the raw encodings take partial blocks, give them room for a whole one
+	return c.enc.DecodedLen(n + c.size)
+}
+
+func (c *blockCodec) decode(dst, src []byte, flush bool) (int, int, error) {
+	if c.padded && len(src) > 0 {
+		return 0, 0, corruptInputError(0)
+	}
+
+	n := len(src) / c.size * c.size
+	if flush {
+		n = len(src)
+	}
+	if p := bytes.IndexByte(src[:n], '='); p >= 0 {
+		if end := (p/c.size + 1) * c.size; end < n {
+			n = end
+		}
+		c.padded = true
+	}
+
+	ndst, err := c.enc.Decode(dst, src[:n])
+	if err != nil {
+		return ndst, 0, err
+	}
+	return ndst, n, nil
+}
+
+//ascii85Codec decodes ascii85, where z stands for four zero bytes
+type ascii85Codec struct{}
+
+func (ascii85Codec) maxDecodedLen(n int) int { return 4*n + 4 }
+
+func (ascii85Codec) decode(dst, src []byte, flush bool) (int, int, error) {
+	return ascii85.Decode(dst, src, flush)
+}
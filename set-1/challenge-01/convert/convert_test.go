@@ -0,0 +1,102 @@
+package convert
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestRoundTrip(t *testing.T) {
+	data := make([]byte, 100000)
+	rand.New(rand.NewSource(1)).Read(data)
+	copy(data[500:], make([]byte, 64))
+
+	for f := Raw; f <= Ascii85; f++ {
+		var enc bytes.Buffer
+		if _, err := Convert(&enc, bytes.NewReader(data), Raw, f); err != nil {
+			t.Fatalf("%v: %v", f, err)
+		}
+
+		//wrap the encoding and feed it a byte at a time to cross every boundary
+		text := enc.Bytes()
+		if f != Raw {
+			text = wrap(text, 76)
+		}
+		dec, err := NewDecoder(f, iotest.OneByteReader(bytes.NewReader(text)))
+		if err != nil {
+			t.Fatalf("%v: %v", f, err)
+		}
+		got, err := ioutil.ReadAll(dec)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("%v: round trip failed (%v)", f, err)
+		}
+	}
+}
+
+func wrap(text []byte, width int) []byte {
+	var out []byte
+	for len(text) > width {
+		out = append(append(out, text[:width]...), "\r\n"...)
+		text = text[width:]
+	}
+	return append(out, text...)
+}
+
+func TestKnownAnswers(t *testing.T) {
+	tests := []struct {
+		f    Format
+		text string
+	}{
+		{Hex, "49276D206b696c6c"},
+		{Base64, "SSdtIGtpbGw="},
+		{Base64Raw, "SSdtIGtpbGw"},
+		{Base64URL, "SSdtIGtpbGw="},
+		{Base32, "JETW2IDLNFWGY==="},
+		{Ascii85, "8LJ?t CM@U$"},
+	}
+
+	for _, tt := range tests {
+		var out strings.Builder
+		if _, err := Convert(&out, strings.NewReader(tt.text), tt.f, Raw); err != nil || out.String() != "I'm kill" {
+			t.Errorf("%v %q: got %q (%v)", tt.f, tt.text, out.String(), err)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		f      Format
+		text   string
+		offset int64
+	}{
+		{Hex, "4927\n6dzz", 7},
+		{Hex, "4927 6d2", 8},
+		{Base64, "SSdt\nIG!p", 7},
+		{Base64, "SSdtI", 4},
+		{Base64, "SSdtIG==SSdt", 8},
+		{Base64Raw, "SSdtIGt=", 7},
+		{Base64URL, "SSdt+Gtp", 4},
+		{Base32, "JETW2IDL1", 8},
+		{Ascii85, "8LJ?tCM{U$", 7},
+	}
+
+	for _, tt := range tests {
+		_, err := Convert(ioutil.Discard, strings.NewReader(tt.text), tt.f, Raw)
+		var se *SyntaxError
+		if !errors.As(err, &se) || se.Offset != tt.offset {
+			t.Errorf("%v %q: expected an error at offset %d, got %v", tt.f, tt.text, tt.offset, err)
+		}
+	}
+
+	//far into a stream, past the first chunk
+	text := strings.Repeat("00", chunkSize) + "0x"
+	_, err := Convert(ioutil.Discard, strings.NewReader(text), Hex, Raw)
+	var se *SyntaxError
+	if !errors.As(err, &se) || se.Offset != 2*chunkSize+1 {
+		t.Errorf("expected an error at offset %d, got %v", 2*chunkSize+1, err)
+	}
+}
@@ -11,9 +11,10 @@ Always operate on raw bytes, never on encoded strings. Only use hex and base64 f
 package main
 
 import (
-	"encoding/base64"
-	"encoding/hex"
 	"fmt"
+	"strings"
+
+	"cryptopals/set-1/challenge-01/convert"
 )
 
 //Hex is the given val
@@ -25,17 +26,15 @@ func main() {
 	if err == nil {
 		fmt.Println(val)
 	} else {
-		fmt.Println("Can not convert number")
+		fmt.Println("Can not convert number: ", err)
 	}
 }
 
 //ToBase64 Converts a hexadecimal number represented via a string to its base64 representation
 func ToBase64(num string) (string, error) {
-	data, err := hex.DecodeString(num)
-
-	if err == nil {
-		return base64.StdEncoding.EncodeToString(data), nil
+	var b strings.Builder
+	if _, err := convert.Convert(&b, strings.NewReader(num), convert.Hex, convert.Base64); err != nil {
+		return "", err
 	}
-
-	return num, err
+	return b.String(), nil
 }
@@ -12,3 +12,11 @@ func TestHexToBase64(t *testing.T) {
 	}
 
 }
+
+func TestHexToBase64Invalid(t *testing.T) {
+	val, err := ToBase64("49276d206b6x")
+
+	if err == nil || val != "" {
+		t.Errorf("Expected an error and no value, got %q (%v)", val, err)
+	}
+}
@@ -0,0 +1,310 @@
+//Package input loads the ciphertexts the challenge commands work on from files or
+//stdin, either as one blob or as one record per line, and decodes them from hex,
+//standard or URL-safe base64 (padded or not, wrapped or not) or takes them as raw
+//bytes. The encoding can be given or detected. Decoding errors point at the line and
+//column of the offending character.
+package input
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+//Encoding is the text encoding of an input
+type Encoding int
+
+const (
+	//Auto detects the encoding: hex if every character is a hex digit, base64 if
+	//every one is in the standard or else the URL-safe alphabet, raw otherwise
+	Auto Encoding = iota
+	Hex
+	Base64
+	Base64URL
+	Raw
+)
+
+var encodingNames = []string{"auto", "hex", "base64", "base64url", "raw"}
+
+func (e Encoding) String() string {
+	if e < 0 || int(e) >= len(encodingNames) {
+		return fmt.Sprintf("Encoding(%d)", int(e))
+	}
+	return encodingNames[e]
+}
+
+//ParseEncoding returns the encoding with the given name, as String spells it
+func ParseEncoding(name string) (Encoding, error) {
+	for i, n := range encodingNames {
+		if n == name {
+			return Encoding(i), nil
+		}
+	}
+	return 0, fmt.Errorf("input: unknown encoding %q", name)
+}
+
+//SyntaxError is a decoding failure, positioned at a 1-based line and column
+type SyntaxError struct {
+	Name         string
+	Line, Column int
+	Msg          string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("input: %s:%d:%d: %s", e.Name, e.Line, e.Column, e.Msg)
+}
+
+//Record is one decoded input. Line is where it started, 1-based.
+type Record struct {
+	Name string
+	Line int
+	Data []byte
+	//Encoding is the one the record was decoded from, never Auto
+	Encoding Encoding
+}
+
+//Options configures Read and Load
+type Options struct {
+	Encoding Encoding
+	//Lines makes every non-empty line a record of its own instead of the whole input
+	//being one
+	Lines bool
+}
+
+//pos is the position of a character that survived stripping whitespace
+type pos struct {
+	line, col int
+}
+
+//strip removes whitespace from text and returns where each remaining character was
+func strip(text []byte, line int) ([]byte, []pos) {
+	out := make([]byte, 0, len(text))
+	where := make([]pos, 0, len(text))
+	col := 1
+
+	for _, b := range text {
+		switch b {
+		case '\n':
+			line, col = line+1, 1
+			continue
+		case ' ', '\t', '\r':
+			col++
+			continue
+		}
+		out = append(out, b)
+		where = append(where, pos{line, col})
+		col++
+	}
+
+	return out, where
+}
+
+func isHex(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
+
+func isBase64(b byte, url bool) bool {
+	switch {
+	case (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9'):
+		return true
+	case url:
+		return b == '-' || b == '_'
+	default:
+		return b == '+' || b == '/'
+	}
+}
+
+//Detect returns the encoding Auto settles on for text
+func Detect(text []byte) Encoding {
+	s, _ := strip(text, 1)
+	if len(s) == 0 {
+		return Raw
+	}
+
+	all := func(ok func(b byte) bool) bool {
+		body := bytes.TrimRight(s, "=")
+		if len(s)-len(body) > 2 {
+			return false
+		}
+		for _, b := range body {
+			if !ok(b) {
+				return false
+			}
+		}
+		return true
+	}
+
+	switch {
+	case len(s)%2 == 0 && len(s) == len(bytes.TrimRight(s, "=")) && all(isHex):
+		return Hex
+	case all(func(b byte) bool { return isBase64(b, false) }):
+		return Base64
+	case all(func(b byte) bool { return isBase64(b, true) }):
+		return Base64URL
+	}
+	return Raw
+}
+
+//Decode decodes text, detecting the encoding when enc is Auto, and returns the
+//encoding used. name and line say where text came from for the errors.
+func Decode(name string, line int, text []byte, enc Encoding) ([]byte, Encoding, error) {
+	if enc == Auto {
+		enc = Detect(text)
+	}
+	if enc == Raw {
+		return append([]byte(nil), text...), Raw, nil
+	}
+
+	s, where := strip(text, line)
+	fail := func(i int, msg string) error {
+		p := pos{line, 1}
+		switch {
+		case i < len(where):
+			p = where[i]
+		case len(where) > 0:
+			p = where[len(where)-1]
+			p.col++
+		}
+		return &SyntaxError{Name: name, Line: p.line, Column: p.col, Msg: msg}
+	}
+
+	switch enc {
+	case Hex:
+		for i, b := range s {
+			if !isHex(b) {
+				return nil, enc, fail(i, fmt.Sprintf("%q is not a hex digit", b))
+			}
+		}
+		if len(s)%2 != 0 {
+			return nil, enc, fail(len(s), "odd number of hex digits")
+		}
+		out := make([]byte, len(s)/2)
+		for i := range out {
+			out[i] = unhex(s[2*i])<<4 | unhex(s[2*i+1])
+		}
+		return out, enc, nil
+
+	case Base64, Base64URL:
+		url := enc == Base64URL
+		body := bytes.TrimRight(s, "=")
+		for i, b := range body {
+			if !isBase64(b, url) {
+				return nil, enc, fail(i, fmt.Sprintf("%q is not a %s character", b, enc))
+			}
+		}
+
+		e := base64.StdEncoding
+		if url {
+			e = base64.URLEncoding
+		}
+		if len(body) == len(s) {
+			e = e.WithPadding(base64.NoPadding)
+		}
+
+		out := make([]byte, e.DecodedLen(len(s)))
+		n, err := e.Decode(out, s)
+		var corrupt base64.CorruptInputError
+		if errors.As(err, &corrupt) {
+			return nil, enc, fail(int(corrupt), "invalid "+enc.String()+" length or padding")
+		}
+		return out[:n], enc, err
+	}
+
+	return nil, enc, fmt.Errorf("input: unknown encoding %v", enc)
+}
+
+func unhex(b byte) byte {
+	switch {
+	case b >= 'a':
+		return b - 'a' + 10
+	case b >= 'A':
+		return b - 'A' + 10
+	}
+	return b - '0'
+}
+
+//Read decodes r as configured by opts. name is used in errors. In line mode with Auto
+//every line is detected on its own.
+func Read(r io.Reader, name string, opts Options) ([]Record, error) {
+	if !opts.Lines {
+		text, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		data, enc, err := Decode(name, 1, text, opts.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		return []Record{{Name: name, Line: 1, Data: data, Encoding: enc}}, nil
+	}
+
+	var records []Record
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 64<<20)
+
+	for line := 1; sc.Scan(); line++ {
+		text := sc.Bytes()
+		if len(bytes.TrimSpace(text)) == 0 {
+			continue
+		}
+		data, enc, err := Decode(name, line, text, opts.Encoding)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, Record{Name: name, Line: line, Data: data, Encoding: enc})
+	}
+
+	return records, sc.Err()
+}
+
+//Load reads every named file, or stdin when there are none or the name is "-"
+func Load(names []string, opts Options) ([]Record, error) {
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+
+	var records []Record
+	for _, name := range names {
+		if name == "-" {
+			r, err := Read(os.Stdin, "stdin", opts)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, r...)
+			continue
+		}
+
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		r, err := Read(f, name, opts)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, r...)
+	}
+
+	return records, nil
+}
+
+//LoadOne is Load for commands that work on a single blob: the records of all the
+//inputs are concatenated
+func LoadOne(names []string, enc Encoding) ([]byte, error) {
+	records, err := Load(names, Options{Encoding: enc})
+	if err != nil {
+		return nil, err
+	}
+
+	var out []byte
+	for _, r := range records {
+		out = append(out, r.Data...)
+	}
+	return out, nil
+}
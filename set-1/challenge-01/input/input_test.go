@@ -0,0 +1,72 @@
+package input
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		text string
+		enc  Encoding
+		want string
+	}{
+		{"49276d\n206b69", Hex, "I'm ki"},
+		{"SSdtIGtp\nbGxpbmc=", Base64, "I'm killing"},
+		{"SSdtIGtpbGxpbmc", Base64, "I'm killing"},
+		{"-_-_", Base64URL, "\xfb\xff\xbf"},
+		{"+/+/", Base64, "\xfb\xff\xbf"},
+		{"I'm killing your brain", Raw, "I'm killing your brain"},
+	}
+
+	for _, tt := range tests {
+		if got := Detect([]byte(tt.text)); got != tt.enc {
+			t.Errorf("%q: expected %v, detected %v", tt.text, tt.enc, got)
+		}
+		data, enc, err := Decode("test", 1, []byte(tt.text), Auto)
+		if err != nil || enc != tt.enc || string(data) != tt.want {
+			t.Errorf("%q: expected %q, got %q as %v (%v)", tt.text, tt.want, data, enc, err)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		text         string
+		enc          Encoding
+		line, column int
+	}{
+		{"4927\n6dzz", Hex, 2, 3},
+		{"4927\n 6d2", Hex, 2, 5},
+		{"SSdt\nIG!p", Base64, 2, 3},
+		{"SSdtI", Base64, 1, 5},
+	}
+
+	for _, tt := range tests {
+		_, _, err := Decode("test", 1, []byte(tt.text), tt.enc)
+		se, ok := err.(*SyntaxError)
+		if !ok || se.Line != tt.line || se.Column != tt.column {
+			t.Errorf("%q: expected an error at %d:%d, got %v", tt.text, tt.line, tt.column, err)
+		}
+	}
+}
+
+func TestRead(t *testing.T) {
+	records, err := Read(strings.NewReader("4927\n\nSSdt\n"), "test", Options{Lines: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[1].Line != 3 || records[1].Encoding != Base64 || string(records[1].Data) != "I'm" {
+		t.Errorf("Unexpected records %+v", records)
+	}
+
+	_, err = Read(strings.NewReader("4927\n\n49zz\n"), "test", Options{Lines: true, Encoding: Hex})
+	if se, ok := err.(*SyntaxError); !ok || se.Line != 3 || se.Column != 3 {
+		t.Errorf("Expected an error at 3:3, got %v", err)
+	}
+
+	records, err = Load([]string{"../../challenge-06/file.txt"}, Options{})
+	if err != nil || len(records) != 1 || records[0].Encoding != Base64 || len(records[0].Data) != 2876 {
+		t.Errorf("Expected the base64 challenge 6 file, got %v", err)
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Recode
+Converts between raw bytes and hex, base64 (standard or URL-safe, padded or raw),
+base32 and ascii85. Input is streamed, so files of any size can be converted, and
+invalid input is reported with the file and byte offset it was found at.
+
+Usage:
+
+	recode [-from hex] [-to base64] [files...]
+
+Formats are raw, hex, base64, base64-raw, base64url, base64url-raw, base32 and
+ascii85. With no files the input is read from stdin. The files are decoded one after
+the other and encoded as one stream to stdout, ending with a newline unless the output
+is raw.
+*/
+
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"cryptopals/set-1/challenge-01/convert"
+)
+
+func main() {
+	fromName := flag.String("from", "hex", "format of the input")
+	toName := flag.String("to", "base64", "format of the output")
+	flag.Parse()
+
+	from, err := convert.ParseFormat(*fromName)
+	checkErr(err)
+	to, err := convert.ParseFormat(*toName)
+	checkErr(err)
+
+	out := bufio.NewWriter(os.Stdout)
+	enc, err := convert.NewEncoder(to, out)
+	checkErr(err)
+
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+	for _, name := range names {
+		checkErr(decode(name, from, enc))
+	}
+
+	checkErr(enc.Close())
+	if to != convert.Raw {
+		fmt.Fprintln(out)
+	}
+	checkErr(out.Flush())
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
+	}
+}
+
+//decode copies the named file, or stdin for "-", decoded from format f to w
+func decode(name string, f convert.Format, w io.Writer) error {
+	r := io.Reader(os.Stdin)
+	if name == "-" {
+		name = "stdin"
+	} else {
+		file, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		r = file
+	}
+
+	dec, err := convert.NewDecoder(f, bufio.NewReader(r))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, dec); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
@@ -17,8 +17,12 @@ package main
 
 import (
 	"encoding/hex"
-	"errors"
+	"flag"
 	"fmt"
+	"os"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-02/xor"
 )
 
 //Given1 first given
@@ -28,6 +32,27 @@ var Given1 = "1c0111001f010100061a024b53535009181c"
 var Given2 = "686974207468652062756c6c277320657965"
 
 func main() {
+	flag.Parse()
+
+	//two files in any encoding replace the givens
+	switch flag.NArg() {
+	case 0:
+	case 2:
+		var bufs [2][]byte
+		for i := range bufs {
+			buf, err := input.LoadOne(flag.Args()[i:i+1], input.Auto)
+			if err != nil {
+				fmt.Println("Error: ", err)
+				return
+			}
+			bufs[i] = buf
+		}
+		Given1, Given2 = hex.EncodeToString(bufs[0]), hex.EncodeToString(bufs[1])
+	default:
+		fmt.Println("Error: expected two files to XOR, or none for the givens")
+		os.Exit(2)
+	}
+
 	xor, err := FixedXOR(Given1, Given2)
 
 	if err == nil {
@@ -39,22 +64,20 @@ func main() {
 
 //FixedXOR returns the XOR combination of two numbers of equal length
 func FixedXOR(num1, num2 string) (string, error) {
-	if len(num1) != len(num2) {
-		return "", errors.New("Numbers are not equal in length")
+	buf1, err := hex.DecodeString(num1)
+	if err != nil {
+		return "", err
 	}
 
-	buf1, err := hex.DecodeString(num1)
 	buf2, err := hex.DecodeString(num2)
+	if err != nil {
+		return "", err
+	}
 
-	if err == nil {
-		var xor []byte
-		xor = make([]byte, len(buf1), len(buf1))
-		for i := range buf1 {
-			xor[i] = buf1[i] ^ buf2[i]
-		}
-
-		return hex.EncodeToString(xor), nil
+	res, err := xor.Fixed(buf1, buf2)
+	if err != nil {
+		return "", err
 	}
 
-	return "", err
+	return hex.EncodeToString(res), nil
 }
@@ -0,0 +1,172 @@
+//Package variant encrypts with the variations on repeating-key XOR that obfuscators
+//use instead of the textbook cipher. In each of them a key byte still covers a column
+//of every size-th byte, which is what the breakers in set-1/challenge-06/variant rely
+//on.
+package variant
+
+import (
+	"fmt"
+
+	"cryptopals/set-1/challenge-02/xor"
+)
+
+//Cipher is one variant of repeating-key XOR
+type Cipher interface {
+	//Name identifies the variant and its parameters
+	Name() string
+	//Encrypt and Decrypt return xor.ErrEmptyKey for an empty key
+	Encrypt(pt, key []byte) ([]byte, error)
+	Decrypt(ct, key []byte) ([]byte, error)
+}
+
+//All holds one of each variant, Incrementing with its step left to be found
+var All = []Cipher{Static{}, Positional{}, Rotating{}, Incrementing{}, Autokey{}, Autokey{Ciphertext: true}}
+
+//ByName maps the names the commands accept to the variants
+var ByName = map[string]Cipher{
+	"static":       Static{},
+	"positional":   Positional{},
+	"rotating":     Rotating{},
+	"incrementing": Incrementing{},
+	"autokey":      Autokey{},
+	"ctautokey":    Autokey{Ciphertext: true},
+}
+
+//Static is textbook repeating-key XOR, for comparison with the others
+type Static struct{}
+
+//Name implements Cipher
+func (Static) Name() string { return "static" }
+
+//Encrypt implements Cipher
+func (Static) Encrypt(pt, key []byte) ([]byte, error) {
+	return xor.Repeating(pt, key)
+}
+
+//Decrypt implements Cipher
+func (c Static) Decrypt(ct, key []byte) ([]byte, error) { return c.Encrypt(ct, key) }
+
+//Positional adds the position to the key byte: byte i is XORed with
+//key[i%len(key)] + i, modulo 256.
+type Positional struct{}
+
+//Name implements Cipher
+func (Positional) Name() string { return "positional" }
+
+//Encrypt implements Cipher
+func (Positional) Encrypt(pt, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, xor.ErrEmptyKey
+	}
+	out := make([]byte, len(pt))
+	for i, b := range pt {
+		out[i] = b ^ (key[i%len(key)] + byte(i))
+	}
+	return out, nil
+}
+
+//Decrypt implements Cipher
+func (c Positional) Decrypt(ct, key []byte) ([]byte, error) { return c.Encrypt(ct, key) }
+
+//Rotating rotates the key left by one byte after every block of len(key) bytes
+type Rotating struct{}
+
+//Name implements Cipher
+func (Rotating) Name() string { return "rotating" }
+
+//Encrypt implements Cipher
+func (Rotating) Encrypt(pt, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, xor.ErrEmptyKey
+	}
+	n := len(key)
+	out := make([]byte, len(pt))
+	for i, b := range pt {
+		out[i] = b ^ key[(i+i/n)%n]
+	}
+	return out, nil
+}
+
+//Decrypt implements Cipher
+func (c Rotating) Decrypt(ct, key []byte) ([]byte, error) { return c.Encrypt(ct, key) }
+
+//Incrementing adds Step to every byte of the key after each block of len(key) bytes,
+//so byte i is XORed with key[i%len(key)] + Step*(i/len(key)), modulo 256. With Step
+//equal to len(key) it is Positional under a different key.
+type Incrementing struct {
+	Step byte
+}
+
+//Name implements Cipher
+func (c Incrementing) Name() string { return fmt.Sprintf("incrementing by %d", c.Step) }
+
+//Encrypt implements Cipher
+func (c Incrementing) Encrypt(pt, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, xor.ErrEmptyKey
+	}
+	n := len(key)
+	out := make([]byte, len(pt))
+	for i, b := range pt {
+		out[i] = b ^ (key[i%n] + c.Step*byte(i/n))
+	}
+	return out, nil
+}
+
+//Decrypt implements Cipher
+func (c Incrementing) Decrypt(ct, key []byte) ([]byte, error) { return c.Encrypt(ct, key) }
+
+//Autokey primes the keystream with the key and then feeds back the plaintext, or the
+//ciphertext when Ciphertext is set: byte i >= len(key) is XORed with byte i-len(key)
+//of the feedback.
+type Autokey struct {
+	Ciphertext bool
+}
+
+//Name implements Cipher
+func (c Autokey) Name() string {
+	if c.Ciphertext {
+		return "ciphertext autokey"
+	}
+	return "plaintext autokey"
+}
+
+//Encrypt implements Cipher
+func (c Autokey) Encrypt(pt, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, xor.ErrEmptyKey
+	}
+	n := len(key)
+	out := make([]byte, len(pt))
+	for i, b := range pt {
+		switch {
+		case i < n:
+			out[i] = b ^ key[i]
+		case c.Ciphertext:
+			out[i] = b ^ out[i-n]
+		default:
+			out[i] = b ^ pt[i-n]
+		}
+	}
+	return out, nil
+}
+
+//Decrypt implements Cipher
+func (c Autokey) Decrypt(ct, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, xor.ErrEmptyKey
+	}
+	n := len(key)
+	out := make([]byte, len(ct))
+	for i, b := range ct {
+		switch {
+		case i < n:
+			out[i] = b ^ key[i]
+		case c.Ciphertext:
+			out[i] = b ^ ct[i-n]
+		default:
+			out[i] = b ^ out[i-n]
+		}
+	}
+	return out, nil
+}
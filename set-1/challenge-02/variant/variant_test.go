@@ -0,0 +1,28 @@
+package variant
+
+import (
+	"bytes"
+	"testing"
+
+	"cryptopals/set-1/challenge-02/xor"
+)
+
+func TestRoundTrip(t *testing.T) {
+	pt := []byte("a short message that spans a few blocks of key")
+	for _, c := range append(All, Incrementing{Step: 3}) {
+		ct, err := c.Encrypt(pt, []byte("key"))
+		if err != nil {
+			t.Fatalf("%s: %v", c.Name(), err)
+		}
+		if got, err := c.Decrypt(ct, []byte("key")); err != nil || !bytes.Equal(got, pt) {
+			t.Errorf("%s: expected %q, got %q (%v)", c.Name(), pt, got, err)
+		}
+
+		if _, err := c.Encrypt(pt, nil); err != xor.ErrEmptyKey {
+			t.Errorf("%s: expected %v encrypting, got %v", c.Name(), xor.ErrEmptyKey, err)
+		}
+		if _, err := c.Decrypt(ct, nil); err != xor.ErrEmptyKey {
+			t.Errorf("%s: expected %v decrypting, got %v", c.Name(), xor.ErrEmptyKey, err)
+		}
+	}
+}
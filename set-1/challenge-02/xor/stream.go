@@ -0,0 +1,71 @@
+package xor
+
+import "io"
+
+type reader struct {
+	r   io.Reader
+	key []byte
+	off int
+}
+
+//NewReader returns a reader that applies repeating-key XOR to everything read from r.
+func NewReader(r io.Reader, key []byte) (io.Reader, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	return &reader{r: r, key: append([]byte(nil), key...)}, nil
+}
+
+func (x *reader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	if n > 0 {
+		RepeatingAt(p[:n], p[:n], x.key, x.off)
+		x.off = (x.off + n) % len(x.key)
+	}
+
+	return n, err
+}
+
+type writer struct {
+	w   io.Writer
+	key []byte
+	off int
+	buf []byte
+}
+
+//NewWriter returns a writer that applies repeating-key XOR to everything written to
+//it before passing it on to w.
+func NewWriter(w io.Writer, key []byte) (io.Writer, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
+
+	return &writer{w: w, key: append([]byte(nil), key...), buf: make([]byte, 32*1024)}, nil
+}
+
+func (x *writer) Write(p []byte) (int, error) {
+	written := 0
+
+	//the caller owns p so we go through our own buffer instead of modifying it
+	for len(p) > 0 {
+		n := len(x.buf)
+		if len(p) < n {
+			n = len(p)
+		}
+		RepeatingAt(x.buf[:n], p[:n], x.key, x.off)
+
+		m, err := x.w.Write(x.buf[:n])
+		x.off = (x.off + m) % len(x.key)
+		written += m
+		if err != nil {
+			return written, err
+		}
+		if m < n {
+			return written, io.ErrShortWrite
+		}
+		p = p[n:]
+	}
+
+	return written, nil
+}
@@ -0,0 +1,169 @@
+package xor
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+//ErrLength is returned when two operands differ in length under the Equal policy
+var ErrLength = errors.New("xor: operands are not equal in length")
+
+//ErrEmptyKey is returned when a key (or the operand to cycle) is empty
+var ErrEmptyKey = errors.New("xor: empty key")
+
+//Policy decides what happens when two operands are not the same length
+type Policy int
+
+const (
+	//Equal refuses operands of different lengths
+	Equal Policy = iota
+	//Truncate stops at the end of the shorter operand
+	Truncate
+	//Cycle repeats the shorter operand until the longer one is exhausted
+	Cycle
+)
+
+//wordSize is the number of bytes combined per step in the fast path
+const wordSize = 8
+
+//patternSize is the minimum size of the expanded key used for repeating-key XOR
+const patternSize = 512
+
+//Fixed returns the XOR combination of two equal-length buffers.
+func Fixed(a, b []byte) ([]byte, error) {
+	return Combine(a, b, Equal)
+}
+
+//Combine returns the XOR combination of a and b, using p to resolve unequal lengths.
+func Combine(a, b []byte, p Policy) ([]byte, error) {
+	switch p {
+	case Equal:
+		if len(a) != len(b) {
+			return nil, ErrLength
+		}
+		dst := make([]byte, len(a))
+		words(dst, a, b)
+		return dst, nil
+	case Truncate:
+		n := len(a)
+		if len(b) < n {
+			n = len(b)
+		}
+		dst := make([]byte, n)
+		words(dst, a[:n], b[:n])
+		return dst, nil
+	case Cycle:
+		//XOR is commutative so the longer operand is always treated as the source
+		if len(a) < len(b) {
+			a, b = b, a
+		}
+		return Repeating(a, b)
+	}
+
+	return nil, errors.New("xor: unknown policy")
+}
+
+//InPlace XORs src into dst, using p to resolve unequal lengths. With Truncate only
+//the common prefix of dst is modified, with Cycle src is repeated over all of dst.
+func InPlace(dst, src []byte, p Policy) error {
+	switch p {
+	case Equal:
+		if len(dst) != len(src) {
+			return ErrLength
+		}
+		words(dst, dst, src)
+	case Truncate:
+		n := len(dst)
+		if len(src) < n {
+			n = len(src)
+		}
+		words(dst[:n], dst[:n], src[:n])
+	case Cycle:
+		return RepeatingAt(dst, dst, src, 0)
+	default:
+		return errors.New("xor: unknown policy")
+	}
+
+	return nil
+}
+
+//Repeating XORs src with key, applying each byte of key in turn and starting over
+//once the key is exhausted.
+func Repeating(src, key []byte) ([]byte, error) {
+	dst := make([]byte, len(src))
+	if err := RepeatingAt(dst, src, key, 0); err != nil {
+		return nil, err
+	}
+
+	return dst, nil
+}
+
+//RepeatingAt XORs src with key into dst as if src started at byte offset of a longer
+//repeating-key stream. dst must be at least as long as src and may alias it.
+func RepeatingAt(dst, src, key []byte, offset int) error {
+	if len(key) == 0 {
+		return ErrEmptyKey
+	}
+	if len(dst) < len(src) {
+		return errors.New("xor: output smaller than input")
+	}
+	if offset < 0 {
+		return errors.New("xor: negative offset")
+	}
+
+	start := offset % len(key)
+
+	//short inputs are not worth expanding the key for
+	if len(src) < patternSize {
+		for i := range src {
+			dst[i] = src[i] ^ key[(start+i)%len(key)]
+		}
+		return nil
+	}
+
+	//expand the key into a pattern that is a whole number of keys long so that each
+	//chunk of src lines up with the key the same way the previous chunk did
+	reps := (patternSize + len(key) - 1) / len(key)
+	pattern := make([]byte, reps*len(key))
+	for i := range pattern {
+		pattern[i] = key[(start+i)%len(key)]
+	}
+
+	for len(src) > 0 {
+		n := len(pattern)
+		if len(src) < n {
+			n = len(src)
+		}
+		words(dst[:n], src[:n], pattern[:n])
+		src = src[n:]
+		dst = dst[n:]
+	}
+
+	return nil
+}
+
+//Single XORs every byte of src with k into dst. dst must be at least as long as src
+//and may alias it.
+func Single(dst, src []byte, k byte) {
+	word := uint64(k) * 0x0101010101010101
+
+	i := 0
+	for ; i+wordSize <= len(src); i += wordSize {
+		binary.LittleEndian.PutUint64(dst[i:], binary.LittleEndian.Uint64(src[i:])^word)
+	}
+	for ; i < len(src); i++ {
+		dst[i] = src[i] ^ k
+	}
+}
+
+//words sets dst[i] = a[i] ^ b[i] a machine word at a time. All three slices must
+//have the same length, dst may alias a or b.
+func words(dst, a, b []byte) {
+	i := 0
+	for ; i+wordSize <= len(a); i += wordSize {
+		binary.LittleEndian.PutUint64(dst[i:], binary.LittleEndian.Uint64(a[i:])^binary.LittleEndian.Uint64(b[i:]))
+	}
+	for ; i < len(a); i++ {
+		dst[i] = a[i] ^ b[i]
+	}
+}
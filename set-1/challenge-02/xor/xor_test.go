@@ -0,0 +1,106 @@
+package xor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"testing"
+)
+
+func TestFixed(t *testing.T) {
+	a, _ := hex.DecodeString("1c0111001f010100061a024b53535009181c")
+	b, _ := hex.DecodeString("686974207468652062756c6c277320657965")
+	expected := "746865206b696420646f6e277420706c6179"
+
+	val, err := Fixed(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(val) != expected {
+		t.Errorf("Expected %q, got %q", expected, hex.EncodeToString(val))
+	}
+
+	if _, err := Fixed(a, b[1:]); err != ErrLength {
+		t.Errorf("Expected %v, got %v", ErrLength, err)
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	a := []byte{1, 2, 3, 4, 5}
+	b := []byte{1, 1}
+
+	val, _ := Combine(a, b, Truncate)
+	if !bytes.Equal(val, []byte{0, 3}) {
+		t.Errorf("Truncate: got %v", val)
+	}
+
+	val, _ = Combine(b, a, Cycle)
+	if !bytes.Equal(val, []byte{0, 3, 2, 5, 4}) {
+		t.Errorf("Cycle: got %v", val)
+	}
+
+	dst := []byte{1, 2, 3}
+	if err := InPlace(dst, []byte{3, 3, 3}, Equal); err != nil || !bytes.Equal(dst, []byte{2, 1, 0}) {
+		t.Errorf("InPlace: got %v, %v", dst, err)
+	}
+}
+
+func TestRepeating(t *testing.T) {
+	pt := "Burning 'em, if you ain't quick and nimble\nI go crazy when I hear a cymbal"
+	expected := "0b3637272a2b2e63622c2e69692a23693a2a3c6324202d623d63343c2a26226324272765272" +
+		"a282b2f20430a652e2c652a3124333a653e2b2027630c692b20283165286326302e27282f"
+
+	val, err := Repeating([]byte(pt), []byte("ICE"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(val) != expected {
+		t.Errorf("Expected %q, got %q", expected, hex.EncodeToString(val))
+	}
+
+	if _, err := Repeating([]byte(pt), nil); err != ErrEmptyKey {
+		t.Errorf("Expected %v, got %v", ErrEmptyKey, err)
+	}
+}
+
+//the fast path has to agree with the naive definition for every key length
+func TestRepeatingLarge(t *testing.T) {
+	src := make([]byte, 10000)
+	for i := range src {
+		src[i] = byte(i * 7)
+	}
+
+	for _, size := range []int{1, 3, 8, 29, 600} {
+		key := make([]byte, size)
+		for i := range key {
+			key[i] = byte(i*13 + 1)
+		}
+
+		val, _ := Repeating(src, key)
+		for i := range src {
+			if val[i] != src[i]^key[i%size] {
+				t.Fatalf("key size %d: mismatch at %d", size, i)
+			}
+		}
+	}
+}
+
+func TestStream(t *testing.T) {
+	pt := bytes.Repeat([]byte("Cooking MC's like a pound of bacon. "), 1000)
+	key := []byte("Terminator X: Bring the noise")
+	expected, _ := Repeating(pt, key)
+
+	var out bytes.Buffer
+	w, _ := NewWriter(&out, key)
+	w.Write(pt[:7])
+	w.Write(pt[7:])
+	if !bytes.Equal(out.Bytes(), expected) {
+		t.Errorf("Writer output does not match Repeating")
+	}
+
+	r, _ := NewReader(bytes.NewReader(expected), key)
+	val, _ := ioutil.ReadAll(r)
+	if !bytes.Equal(val, pt) {
+		t.Errorf("Reader did not undo the Writer")
+	}
+}
@@ -0,0 +1,60 @@
+// +build ignore
+
+//gen_english_table.go writes english_table.go, the built-in English model, from the
+//corpus named on its command line. The corpus in testdata is the license texts Debian
+//ships in /usr/share/common-licenses (GPL, Apache, MPL...), long and freely
+//redistributable English prose, in name order with their paragraphs unwrapped to
+//single lines so that the hard line breaks of the files do not count as English.
+//
+//Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//minCounts drops rare n-grams to keep the table small, by length
+var minCounts = [score.MaxOrder + 1]uint64{2: 1, 3: 2, 4: 3}
+
+func main() {
+	if len(os.Args) != 2 {
+		checkErr(fmt.Errorf("usage: go run gen_english_table.go corpus.txt"))
+	}
+	corpus, err := ioutil.ReadFile(os.Args[1])
+	checkErr(err)
+
+	counts, err := score.Train(bytes.NewReader(corpus), score.MaxOrder, true)
+	checkErr(err)
+	for n := 2; n <= score.MaxOrder; n++ {
+		for g, v := range counts.Grams[n] {
+			if v < minCounts[n] {
+				delete(counts.Grams[n], g)
+			}
+		}
+	}
+
+	var table bytes.Buffer
+	fmt.Fprintf(&table, "# Byte and n-gram counts of the English prose of %s (%d bytes).\n", os.Args[1], len(corpus))
+	_, err = counts.WriteTo(&table)
+	checkErr(err)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by gen_english_table.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&out, "package score\n\n")
+	fmt.Fprintf(&out, "//englishTable is the built-in English model in the format read by Load\n")
+	fmt.Fprintf(&out, "const englishTable = `\n%s`\n", table.Bytes())
+
+	checkErr(ioutil.WriteFile("english_table.go", out.Bytes(), 0644))
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,162 @@
+package score
+
+import (
+	"io"
+	"math"
+	"strings"
+	"sync"
+)
+
+//MaxOrder is the longest n-gram a Model can hold
+const MaxOrder = 4
+
+//smoothing is the pseudo count given to bytes and n-grams never seen in training
+const smoothing = 0.01
+
+//Model is a byte level n-gram language model. It scores text by the average log10
+//probability of each byte plus that of every n-gram of length Order, so spaces,
+//punctuation and control bytes count just as much as letters do.
+type Model struct {
+	//Order is the n-gram length used by Score, from 1 (bytes only) to MaxOrder
+	Order int
+	//Fold maps ASCII upper case to lower case before looking up n-grams
+	Fold bool
+
+	bytes [256]float64
+	grams [MaxOrder + 1]map[uint32]float64
+	floor [MaxOrder + 1]float64
+}
+
+//Score implements Scorer
+func (m *Model) Score(text []byte) float64 {
+	if len(text) == 0 {
+		return math.Inf(-1)
+	}
+
+	total := 0.0
+	for _, b := range text {
+		total += m.bytes[b]
+	}
+
+	//short inputs fall back to the longest n-gram that fits
+	n := m.Order
+	if len(text) < n {
+		n = len(text)
+	}
+
+	if n >= 2 {
+		grams := m.grams[n]
+		mask := uint32(1<<(8*uint(n)) - 1)
+		var g uint32
+
+		for i, b := range text {
+			g = (g<<8 | uint32(m.fold(b))) & mask
+			if i < n-1 {
+				continue
+			}
+
+			if p, ok := grams[g]; ok {
+				total += p
+			} else {
+				total += m.floor[n]
+			}
+		}
+	}
+
+	return total / float64(len(text))
+}
+
+//WithOrder returns a copy of m that scores with n-grams of length n. The tables are
+//shared with m.
+func (m *Model) WithOrder(n int) *Model {
+	if n < 1 {
+		n = 1
+	}
+	if n > MaxOrder {
+		n = MaxOrder
+	}
+
+	cp := *m
+	cp.Order = n
+	return &cp
+}
+
+func (m *Model) fold(b byte) byte {
+	if m.Fold && b >= 'A' && b <= 'Z' {
+		return b + ('a' - 'A')
+	}
+	return b
+}
+
+//Load reads a model from the text format below, as written by Counts.WriteTo. Blank
+//lines and lines starting with # are ignored.
+//
+//	order <n>
+//	fold <true|false>
+//	<n> <hex encoded n-gram> <count>
+//
+//Byte frequencies are the n-grams of length 1. Counts are turned into smoothed log
+//probabilities so a table only needs the n-grams that were actually seen.
+func Load(r io.Reader) (*Model, error) {
+	c, err := ReadCounts(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Model(), nil
+}
+
+//build turns raw counts into log probabilities
+func (m *Model) build(c *Counts) {
+	total := 0.0
+	for _, v := range c.Grams[1] {
+		total += float64(v)
+	}
+	for b := range m.bytes {
+		m.bytes[b] = math.Log10((float64(c.Grams[1][uint32(b)]) + smoothing) / (total + 256*smoothing))
+	}
+
+	for n := 2; n <= MaxOrder; n++ {
+		total := 0.0
+		for _, v := range c.Grams[n] {
+			total += float64(v)
+		}
+
+		m.grams[n] = make(map[uint32]float64, len(c.Grams[n]))
+		for g, v := range c.Grams[n] {
+			m.grams[n][g] = math.Log10(float64(v) / total)
+		}
+		m.floor[n] = math.Log10(smoothing / (total + 1))
+	}
+}
+
+//pack turns an n-gram of at most four bytes into a map key
+func pack(gram []byte) uint32 {
+	var g uint32
+	for _, b := range gram {
+		g = g<<8 | uint32(b)
+	}
+	return g
+}
+
+//english_table.go is counted from the license texts of Debian, see gen_english_table.go
+//go:generate go run gen_english_table.go testdata/corpus.txt
+
+var (
+	englishOnce  sync.Once
+	englishModel *Model
+)
+
+//EnglishModel returns the built-in quadgram model of English text. Use WithOrder(1) to
+//score bytes that are not contiguous, like the columns of a repeating-key XOR.
+func EnglishModel() *Model {
+	englishOnce.Do(func() {
+		m, err := Load(strings.NewReader(englishTable))
+		if err != nil {
+			panic("score: embedded English table is corrupt: " + err.Error())
+		}
+		englishModel = m
+	})
+
+	return englishModel
+}
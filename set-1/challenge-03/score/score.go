@@ -0,0 +1,229 @@
+package score
+
+import "math"
+
+//Scorer rates how plausible a candidate plaintext is. Higher scores are always
+//more plausible, whatever the underlying statistic.
+type Scorer interface {
+	Score(text []byte) float64
+}
+
+//ScorerFunc adapts an ordinary function to the Scorer interface
+type ScorerFunc func(text []byte) float64
+
+//Score calls f(text)
+func (f ScorerFunc) Score(text []byte) float64 {
+	return f(text)
+}
+
+//English frequency from A to Z
+var English = [26]float64{
+	0.08167, 0.01492, 0.02782, 0.04253, 0.12702, 0.02228, 0.02015,
+	0.06094, 0.06966, 0.00153, 0.00772, 0.04025, 0.02406, 0.06749,
+	0.07507, 0.01929, 0.00095, 0.05987, 0.06327, 0.09056, 0.02758,
+	0.00978, 0.02360, 0.00150, 0.01974, 0.00074,
+}
+
+//letterCounts returns the observed number of occurence of each letter (case folded)
+//and the total number of letters in text.
+func letterCounts(text []byte) ([26]int, int) {
+	var alpCount [26]int
+	validCharCount := 0
+
+	for _, val := range text {
+		if val >= 'A' && val <= 'Z' {
+			alpCount[int(val-'A')]++
+			validCharCount++
+		} else if val >= 'a' && val <= 'z' {
+			alpCount[int(val-'a')]++
+			validCharCount++
+		}
+	}
+
+	return alpCount, validCharCount
+}
+
+//ChiSquared scores text with a chi squared test of its letter counts against Freq.
+//The statistic is negated so that a closer fit gives a higher score.
+type ChiSquared struct {
+	Freq [26]float64
+}
+
+//Score implements Scorer
+func (c ChiSquared) Score(text []byte) float64 {
+	alpCount, validCharCount := letterCounts(text)
+
+	//there is nothing to compare against, this is as implausible as it gets
+	if validCharCount == 0 {
+		return math.Inf(-1)
+	}
+
+	chi2 := 0.0
+	for i, val := range alpCount {
+		//the expected value is the frequency of a letter times the number of valid
+		//characters (letters) in the text.
+		expected := c.Freq[i] * float64(validCharCount)
+		diff := float64(val) - expected
+		chi2 += (diff * diff) / expected
+	}
+
+	return -chi2
+}
+
+//Weighted scores text by multiplying the expected occurence of every lower case
+//letter with its observed occurence.
+type Weighted struct {
+	Freq [26]float64
+}
+
+//Score implements Scorer
+func (w Weighted) Score(text []byte) float64 {
+	var alpCount [26]int
+	validCharCount := 0
+
+	//only lower case letters count. A key that is off by 0x20 swaps the case of every
+	//letter, and English prose is mostly lower case so this picks the right one.
+	for _, val := range text {
+		if val >= 'a' && val <= 'z' {
+			alpCount[int(val-'a')]++
+			validCharCount++
+		}
+	}
+
+	chi := 0.0
+	for i, val := range alpCount {
+		//The score for a letter is: the expected occurence (freq of letter * length of text) of that
+		//letter multiplied by the observed occurence of that letter within the text.
+		//We can assume that letters with the highest frequency will make the bulk of the sentence,
+		//so if these letter are not present the score will be effectively penalized.
+		chi += w.Freq[i] * float64(validCharCount) * float64(val)
+	}
+
+	return chi
+}
+
+//Printable scores text by the fraction of its bytes that are printable ASCII or
+//common whitespace.
+type Printable struct{}
+
+//Score implements Scorer
+func (Printable) Score(text []byte) float64 {
+	if len(text) == 0 {
+		return 0
+	}
+
+	n := 0
+	for _, val := range text {
+		if isPrintable(val) {
+			n++
+		}
+	}
+
+	return float64(n) / float64(len(text))
+}
+
+//SpaceAware scores text by the average per byte weight of English prose: letters
+//are weighted by Freq, spaces by Space, other printable characters get a small
+//bonus and anything else is penalized.
+type SpaceAware struct {
+	Freq  [26]float64
+	Space float64
+}
+
+//Score implements Scorer
+func (s SpaceAware) Score(text []byte) float64 {
+	if len(text) == 0 {
+		return 0
+	}
+
+	//letters and spaces share the whole of the probability mass
+	letterShare := 1 - s.Space
+	total := 0.0
+
+	for _, val := range text {
+		switch {
+		case val >= 'a' && val <= 'z':
+			total += s.Freq[val-'a'] * letterShare
+		case val >= 'A' && val <= 'Z':
+			//capitals are rarer than lower case so they count for a bit less
+			total += s.Freq[val-'A'] * letterShare / 2
+		case val == ' ':
+			total += s.Space
+		case isPrintable(val):
+			total += 0.001
+		default:
+			total -= 0.1
+		}
+	}
+
+	return total / float64(len(text))
+}
+
+func isPrintable(b byte) bool {
+	return (b >= 0x20 && b < 0x7f) || b == '\n' || b == '\r' || b == '\t'
+}
+
+//DefaultSpace is the rough frequency of a space in English prose
+const DefaultSpace = 0.13
+
+var (
+	//EnglishChiSquared is ChiSquared over English letter frequencies
+	EnglishChiSquared Scorer = ChiSquared{Freq: English}
+	//EnglishWeighted is Weighted over English letter frequencies
+	EnglishWeighted Scorer = Weighted{Freq: English}
+	//EnglishSpaceAware is SpaceAware over English letter and space frequencies
+	EnglishSpaceAware Scorer = SpaceAware{Freq: English, Space: DefaultSpace}
+)
+
+//Entropy returns the Shannon entropy of the bytes of text in bits per byte, from 0 for
+//a single repeated byte to 8 for uniformly random data.
+func Entropy(text []byte) float64 {
+	if len(text) == 0 {
+		return 0
+	}
+
+	var counts [256]int
+	for _, val := range text {
+		counts[val]++
+	}
+
+	h := 0.0
+	for _, n := range counts {
+		if n > 0 {
+			p := float64(n) / float64(len(text))
+			h -= p * math.Log2(p)
+		}
+	}
+
+	return h
+}
+
+//LowEntropy scores text by its negated entropy, so structured data beats data that
+//looks random. XOR with a single byte only relabels bytes, so this cannot tell the
+//keys of a single-byte XOR apart, but it does tell whole plaintexts apart.
+type LowEntropy struct{}
+
+//Score implements Scorer
+func (LowEntropy) Score(text []byte) float64 {
+	return -Entropy(text)
+}
+
+//Zeros scores text by the fraction of its bytes that are zero. Zero is by far the most
+//common byte of most binary formats (padding, high bytes of small integers...).
+type Zeros struct{}
+
+//Score implements Scorer
+func (Zeros) Score(text []byte) float64 {
+	if len(text) == 0 {
+		return 0
+	}
+
+	n := 0
+	for _, val := range text {
+		if val == 0 {
+			n++
+		}
+	}
+
+	return float64(n) / float64(len(text))
+}
@@ -0,0 +1,111 @@
+package score
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+//the challenge 3 plaintext should beat every other key. Weighted is left out, it is
+//too crude for a sample this short (which is why challenge 3 uses chi squared).
+func TestScorersPickEnglish(t *testing.T) {
+	ctxt, _ := hex.DecodeString("1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736")
+	expected := "Cooking MC's like a pound of bacon"
+
+	scorers := map[string]Scorer{
+		"chi squared": EnglishChiSquared,
+		"space aware": EnglishSpaceAware,
+	}
+
+	for name, s := range scorers {
+		best := ""
+		high := 0.0
+		for k := 0; k < 256; k++ {
+			pt := make([]byte, len(ctxt))
+			for i := range ctxt {
+				pt[i] = ctxt[i] ^ byte(k)
+			}
+			if val := s.Score(pt); k == 0 || val > high {
+				high = val
+				best = string(pt)
+			}
+		}
+
+		if best != expected {
+			t.Errorf("%s: Expected %q, got %q", name, expected, best)
+		}
+	}
+}
+
+func TestPrintable(t *testing.T) {
+	if val := (Printable{}).Score([]byte("ab\x00\x01")); val != 0.5 {
+		t.Errorf("Expected 0.5, got %v", val)
+	}
+}
+
+func TestModel(t *testing.T) {
+	m := EnglishModel()
+	english := []byte("the quick brown fox jumps over the lazy dog")
+	reversed := []byte("god yzal eht revo spmuj xof nworb kciuq eht")
+	binary := []byte("\x8f\x02t\xe1\x00\x17q\x90z\x03\x1c\xfe")
+
+	if m.Score(english) <= m.Score(reversed) {
+		t.Errorf("Quadgrams should prefer English over reversed English")
+	}
+	if m.WithOrder(1).Score(reversed) <= m.WithOrder(1).Score(binary) {
+		t.Errorf("Bytes should prefer letters over binary")
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	_, err := Load(strings.NewReader("order 4\n2 7468 10\n3 zz 1\n"))
+	if err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("Expected an error on line 3, got %v", err)
+	}
+}
+
+//counting in pieces, saving and loading should not change the model
+func TestTrainRoundTrip(t *testing.T) {
+	corpus := "Der schnelle braune Fuchs springt über den faulen Hund."
+
+	whole, _ := Train(strings.NewReader(corpus), 3, true)
+	pieces, _ := NewCounts(3, true)
+	pieces.Write([]byte(corpus[:10]))
+	pieces.Write([]byte(corpus[10:]))
+
+	var buf1, buf2 bytes.Buffer
+	whole.WriteTo(&buf1)
+	pieces.WriteTo(&buf2)
+	if buf1.String() != buf2.String() {
+		t.Fatalf("Counting in pieces gave different counts")
+	}
+
+	m, err := Load(&buf1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sample := []byte("der faule Hund")
+	if m.Score(sample) != whole.Model().Score(sample) {
+		t.Errorf("Loaded model scores %v, expected %v", m.Score(sample), whole.Model().Score(sample))
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	if val := Entropy([]byte("aaaa")); val != 0 {
+		t.Errorf("Expected 0, got %v", val)
+	}
+
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	if val := Entropy(all); val != 8 {
+		t.Errorf("Expected 8, got %v", val)
+	}
+
+	if val := (Zeros{}).Score([]byte{0, 1, 0, 2}); val != 0.5 {
+		t.Errorf("Expected 0.5, got %v", val)
+	}
+}
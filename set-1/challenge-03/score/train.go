@@ -0,0 +1,216 @@
+package score
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+//Counts holds the byte and n-gram counts of a training corpus. It is an io.Writer so
+//a corpus of any size can be fed to it with io.Copy.
+type Counts struct {
+	//Order is the longest n-gram counted
+	Order int
+	//Fold maps ASCII upper case to lower case before counting n-grams. Bytes are
+	//always counted as they are.
+	Fold bool
+	//Grams[n] maps an n-gram, packed big endian into a uint32, to the number of times
+	//it was seen. Grams[1] holds the frequency of every one of the 256 byte values.
+	Grams [MaxOrder + 1]map[uint32]uint64
+
+	window uint32
+	seen   int
+}
+
+//NewCounts returns empty counts for n-grams up to order
+func NewCounts(order int, fold bool) (*Counts, error) {
+	if order < 1 || order > MaxOrder {
+		return nil, fmt.Errorf("score: order must be between 1 and %d", MaxOrder)
+	}
+
+	c := &Counts{Order: order, Fold: fold}
+	for n := 1; n <= MaxOrder; n++ {
+		c.Grams[n] = make(map[uint32]uint64)
+	}
+
+	return c, nil
+}
+
+//Write counts every byte of p and every n-gram ending in p. N-grams are counted
+//across calls, as if all writes were one continuous text.
+func (c *Counts) Write(p []byte) (int, error) {
+	for _, b := range p {
+		c.Grams[1][uint32(b)]++
+
+		if c.Fold && b >= 'A' && b <= 'Z' {
+			b += 'a' - 'A'
+		}
+		c.window = c.window<<8 | uint32(b)
+		c.seen++
+
+		for n := 2; n <= c.Order && n <= c.seen; n++ {
+			c.Grams[n][c.window&uint32(1<<(8*uint(n))-1)]++
+		}
+	}
+
+	return len(p), nil
+}
+
+//Prune drops every n-gram of length 2 or more seen fewer than min times. Byte
+//frequencies are never pruned.
+func (c *Counts) Prune(min uint64) {
+	for n := 2; n <= MaxOrder; n++ {
+		for g, v := range c.Grams[n] {
+			if v < min {
+				delete(c.Grams[n], g)
+			}
+		}
+	}
+}
+
+//WriteTo serializes c in the format read by Load. N-grams are written in order so the
+//same corpus always gives the same file.
+func (c *Counts) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var written int64
+
+	put := func(format string, args ...interface{}) error {
+		n, err := fmt.Fprintf(bw, format, args...)
+		written += int64(n)
+		return err
+	}
+
+	if err := put("order %d\nfold %t\n", c.Order, c.Fold); err != nil {
+		return written, err
+	}
+
+	for n := 1; n <= c.Order; n++ {
+		grams := make([]uint32, 0, len(c.Grams[n]))
+		for g := range c.Grams[n] {
+			grams = append(grams, g)
+		}
+		sort.Slice(grams, func(i, j int) bool { return grams[i] < grams[j] })
+
+		for _, g := range grams {
+			if err := put("%d %s %d\n", n, hex.EncodeToString(unpack(g, n)), c.Grams[n][g]); err != nil {
+				return written, err
+			}
+		}
+	}
+
+	return written, bw.Flush()
+}
+
+//Model turns the counts into a model scoring with n-grams of length Order
+func (c *Counts) Model() *Model {
+	m := &Model{Order: c.Order, Fold: c.Fold}
+	m.build(c)
+	return m
+}
+
+//ReadCounts reads counts written by WriteTo. Blank lines and lines starting with # are
+//ignored.
+func ReadCounts(r io.Reader) (*Counts, error) {
+	c, _ := NewCounts(MaxOrder, false)
+
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		if len(fields) != 2 && len(fields) != 3 {
+			return nil, fmt.Errorf("score: line %d: expected 2 or 3 fields, got %d", line, len(fields))
+		}
+
+		var err error
+		switch fields[0] {
+		case "order":
+			c.Order, err = strconv.Atoi(fields[1])
+			if err == nil && (c.Order < 1 || c.Order > MaxOrder) {
+				err = errors.New("order out of range")
+			}
+		case "fold":
+			c.Fold, err = strconv.ParseBool(fields[1])
+		default:
+			err = c.add(fields)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("score: line %d: %v", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *Counts) add(fields []string) error {
+	if len(fields) != 3 {
+		return errors.New("expected <n> <gram> <count>")
+	}
+
+	n, err := strconv.Atoi(fields[0])
+	if err != nil || n < 1 || n > MaxOrder {
+		return fmt.Errorf("bad n-gram length %q", fields[0])
+	}
+
+	gram, err := hex.DecodeString(fields[1])
+	if err != nil || len(gram) != n {
+		return fmt.Errorf("bad %d-gram %q", n, fields[1])
+	}
+
+	v, err := strconv.ParseUint(fields[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad count %q", fields[2])
+	}
+
+	c.Grams[n][pack(gram)] += v
+	return nil
+}
+
+//Train counts the corpus read from r
+func Train(r io.Reader, order int, fold bool) (*Counts, error) {
+	c, err := NewCounts(order, fold)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.Copy(c, r); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+//LoadFile reads a model written by the train command
+func LoadFile(name string) (*Model, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return Load(f)
+}
+
+//unpack is the inverse of pack
+func unpack(g uint32, n int) []byte {
+	gram := make([]byte, n)
+	for i := n - 1; i >= 0; i-- {
+		gram[i] = byte(g)
+		g >>= 8
+	}
+	return gram
+}
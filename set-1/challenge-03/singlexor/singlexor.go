@@ -0,0 +1,65 @@
+package singlexor
+
+import (
+	"sort"
+
+	"cryptopals/set-1/challenge-02/xor"
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//Candidate is the result of decrypting a ciphertext under one key byte
+type Candidate struct {
+	Key       byte
+	Plaintext []byte
+	//Score is what the scorer gave Plaintext, higher is more plausible
+	Score float64
+	//Margin is how far Score is ahead of the next best candidate. A small margin
+	//means the scorer could barely tell the two apart.
+	Margin float64
+}
+
+//Rank tries every key byte on ctxt and returns the n candidates s rates highest,
+//best first. Ties are broken by the lower key byte.
+func Rank(ctxt []byte, s score.Scorer, n int) []Candidate {
+	if n > 256 {
+		n = 256
+	}
+	if n < 1 {
+		return nil
+	}
+
+	all := make([]Candidate, 256)
+	plaintext := make([]byte, len(ctxt))
+
+	//we loop around all possible ASCII characters as one of these character
+	//was used as the key.
+	for i := range all {
+		xor.Single(plaintext, ctxt, byte(i))
+		all[i] = Candidate{Key: byte(i), Score: s.Score(plaintext)}
+	}
+
+	sort.SliceStable(all, func(i, j int) bool { return all[i].Score > all[j].Score })
+
+	for i := 0; i < n; i++ {
+		all[i].Plaintext = make([]byte, len(ctxt))
+		xor.Single(all[i].Plaintext, ctxt, all[i].Key)
+		if i+1 < len(all) {
+			all[i].Margin = margin(all[i].Score, all[i+1].Score)
+		}
+	}
+
+	return all[:n]
+}
+
+//Best returns the single most plausible candidate for ctxt
+func Best(ctxt []byte, s score.Scorer) Candidate {
+	return Rank(ctxt, s, 1)[0]
+}
+
+func margin(a, b float64) float64 {
+	//two candidates the scorer rates as equally impossible are not apart at all
+	if a == b {
+		return 0
+	}
+	return a - b
+}
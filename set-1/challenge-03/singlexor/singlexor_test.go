@@ -0,0 +1,35 @@
+package singlexor
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"cryptopals/set-1/challenge-03/score"
+)
+
+func TestRank(t *testing.T) {
+	ctxt, _ := hex.DecodeString("1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736")
+	expected := "Cooking MC's like a pound of bacon"
+
+	top := Rank(ctxt, score.EnglishChiSquared, 5)
+	if len(top) != 5 {
+		t.Fatalf("Expected 5 candidates, got %d", len(top))
+	}
+
+	if top[0].Key != 'X' || string(top[0].Plaintext) != expected {
+		t.Errorf("Expected %q, got %q", expected, top[0].Plaintext)
+	}
+
+	for i := 0; i < len(top)-1; i++ {
+		if top[i].Score < top[i+1].Score {
+			t.Errorf("Candidates out of order at %d", i)
+		}
+		if top[i].Margin != top[i].Score-top[i+1].Score {
+			t.Errorf("Margin of candidate %d is %v, expected %v", i, top[i].Margin, top[i].Score-top[i+1].Score)
+		}
+	}
+
+	if len(Rank(ctxt, score.EnglishChiSquared, 1000)) != 256 {
+		t.Errorf("Expected every key byte when asking for more than 256 candidates")
+	}
+}
@@ -0,0 +1,73 @@
+/*
+Train a language model for the scorers
+Reads a sample corpus in any language or format (prose, JSON logs, source code...)
+and writes its byte and n-gram counts in the format read by score.Load, so
+Decipher and findKey can break XOR over plaintexts that are not English.
+
+Usage:
+
+	train [-order 4] [-fold] [-min 2] [-o model.txt] [corpus files...]
+
+With no files the corpus is read from stdin.
+*/
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"cryptopals/set-1/challenge-03/score"
+)
+
+func main() {
+	order := flag.Int("order", score.MaxOrder, "longest n-gram to count")
+	fold := flag.Bool("fold", false, "fold ASCII upper case into lower case for n-grams")
+	minCount := flag.Uint64("min", 1, "drop n-grams seen fewer than this many times")
+	out := flag.String("o", "", "output file (default stdout)")
+	flag.Parse()
+
+	counts, err := score.NewCounts(*order, *fold)
+	checkErr(err)
+
+	if flag.NArg() == 0 {
+		_, err = io.Copy(counts, os.Stdin)
+		checkErr(err)
+	}
+
+	for _, name := range flag.Args() {
+		checkErr(countFile(counts, name))
+	}
+
+	counts.Prune(*minCount)
+
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		checkErr(err)
+		defer w.Close()
+	}
+
+	_, err = counts.WriteTo(w)
+	checkErr(err)
+}
+
+func countFile(counts *score.Counts, name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(counts, f)
+	return err
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,228 @@
+package detect
+
+import (
+	"bufio"
+	"bytes"
+	"container/heap"
+	"io"
+	"runtime"
+	"sort"
+	"sync"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-03/singlexor"
+)
+
+//batchSize is the number of records handed to a worker at a time
+const batchSize = 256
+
+//Result is the best single-byte XOR candidate for one record of the input
+type Result struct {
+	//Line is the 1 based line number of the record
+	Line int
+	//Offset is the byte offset of the start of the record in the input
+	Offset int64
+	singlexor.Candidate
+}
+
+//Decoder turns the text of a record into the ciphertext to brute-force
+type Decoder func(record []byte) ([]byte, error)
+
+//Options configures Scan. The zero value scans hex records with the English model
+//on every CPU and keeps the 10 best.
+type Options struct {
+	//Workers is the number of goroutines brute-forcing records
+	Workers int
+	//TopK is the number of results to keep
+	TopK int
+	//Decode decodes a record, records it rejects are skipped
+	Decode Decoder
+	//Scorer rates the candidate plaintexts
+	Scorer score.Scorer
+	//Found, if set, is called with every result that makes it into the top K at the
+	//time it is seen. Calls come from a single goroutine.
+	Found func(Result)
+}
+
+//Stats summarises a scan
+type Stats struct {
+	//Records is the number of lines read
+	Records int
+	//Skipped is the number of blank or undecodable lines
+	Skipped int
+}
+
+//Input returns a Decoder for records in enc, decoded by the input package. With
+//input.Auto the encoding of every record is detected on its own.
+func Input(enc input.Encoding) Decoder {
+	return func(record []byte) ([]byte, error) {
+		data, _, err := input.Decode("", 0, record, enc)
+		return data, err
+	}
+}
+
+type record struct {
+	line   int
+	offset int64
+	data   []byte
+}
+
+//Scan reads line oriented records from r, brute-forces every one of them against all
+//single-byte keys in parallel and returns the best TopK results, best first.
+func Scan(r io.Reader, opts Options) ([]Result, Stats, error) {
+	if opts.Workers < 1 {
+		opts.Workers = runtime.NumCPU()
+	}
+	if opts.TopK < 1 {
+		opts.TopK = 10
+	}
+	if opts.Decode == nil {
+		opts.Decode = Input(input.Hex)
+	}
+	if opts.Scorer == nil {
+		opts.Scorer = score.EnglishModel()
+	}
+
+	batches := make(chan []record, opts.Workers)
+	results := make(chan []Result, opts.Workers)
+	var stats Stats
+
+	var wg sync.WaitGroup
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for batch := range batches {
+				results <- solve(batch, opts)
+			}
+		}()
+	}
+
+	//collect on our own goroutine so the top K is only touched from one place
+	top := &topK{max: opts.TopK}
+	done := make(chan struct{})
+	go func() {
+		for res := range results {
+			for _, r := range res {
+				if top.offer(r) && opts.Found != nil {
+					opts.Found(r)
+				}
+			}
+		}
+		close(done)
+	}()
+
+	records, err := split(r, batches)
+	close(batches)
+	wg.Wait()
+	close(results)
+	<-done
+
+	stats.Records = records
+	stats.Skipped = records - top.seen
+	return top.sorted(), stats, err
+}
+
+//split reads r line by line and sends the records in batches
+func split(r io.Reader, batches chan<- []record) (int, error) {
+	br := bufio.NewReader(r)
+	batch := make([]record, 0, batchSize)
+	var offset int64
+	line := 0
+
+	for {
+		text, err := br.ReadBytes('\n')
+		if len(text) > 0 {
+			line++
+			data := bytes.TrimSpace(text)
+			if len(data) > 0 {
+				batch = append(batch, record{line, offset, data})
+			}
+			offset += int64(len(text))
+
+			if len(batch) == batchSize {
+				batches <- batch
+				batch = make([]record, 0, batchSize)
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return line, err
+		}
+	}
+
+	if len(batch) > 0 {
+		batches <- batch
+	}
+
+	return line, nil
+}
+
+func solve(batch []record, opts Options) []Result {
+	res := make([]Result, 0, len(batch))
+
+	for _, rec := range batch {
+		ctxt, err := opts.Decode(rec.data)
+		if err != nil || len(ctxt) == 0 {
+			continue
+		}
+		res = append(res, Result{rec.line, rec.offset, singlexor.Best(ctxt, opts.Scorer)})
+	}
+
+	return res
+}
+
+//topK is a min heap of the best results seen so far
+type topK struct {
+	max   int
+	seen  int
+	items []Result
+}
+
+//offer adds r if it is among the best max results, it reports whether it was kept
+func (t *topK) offer(r Result) bool {
+	t.seen++
+
+	if len(t.items) < t.max {
+		heap.Push(t, r)
+		return true
+	}
+
+	if !better(r, t.items[0]) {
+		return false
+	}
+
+	t.items[0] = r
+	heap.Fix(t, 0)
+	return true
+}
+
+func (t *topK) sorted() []Result {
+	out := append([]Result(nil), t.items...)
+	sort.Slice(out, func(i, j int) bool { return better(out[i], out[j]) })
+	return out
+}
+
+//better orders results by score, earlier lines win ties so the output does not
+//depend on scheduling
+func better(a, b Result) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.Line < b.Line
+}
+
+func (t *topK) Len() int           { return len(t.items) }
+func (t *topK) Less(i, j int) bool { return better(t.items[j], t.items[i]) }
+func (t *topK) Swap(i, j int)      { t.items[i], t.items[j] = t.items[j], t.items[i] }
+func (t *topK) Push(x interface{}) { t.items = append(t.items, x.(Result)) }
+
+func (t *topK) Pop() interface{} {
+	r := t.items[len(t.items)-1]
+	t.items = t.items[:len(t.items)-1]
+	return r
+}
@@ -0,0 +1,59 @@
+package detect
+
+import (
+	"encoding/base64"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-03/score"
+)
+
+func TestScan(t *testing.T) {
+	expected := "Now that the party is jumping\n"
+
+	var runs [][]Result
+	for _, workers := range []int{1, 8} {
+		f, err := os.Open("../file.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		found := 0
+		top, stats, err := Scan(f, Options{Workers: workers, TopK: 3, Scorer: score.EnglishWeighted, Found: func(Result) { found++ }})
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if stats.Records != 327 || stats.Skipped != 0 {
+			t.Errorf("Unexpected stats %+v", stats)
+		}
+		if len(top) != 3 || found < 3 {
+			t.Fatalf("Expected 3 results and at least 3 reports, got %d and %d", len(top), found)
+		}
+		if top[0].Line != 171 || top[0].Offset != 10368 || string(top[0].Plaintext) != expected {
+			t.Errorf("Expected line 171 %q, got line %d %q", expected, top[0].Line, top[0].Plaintext)
+		}
+		runs = append(runs, top)
+	}
+
+	if !reflect.DeepEqual(runs[0], runs[1]) {
+		t.Errorf("Results depend on the number of workers")
+	}
+}
+
+func TestScanBase64(t *testing.T) {
+	ctxt := make([]byte, 0)
+	for _, b := range []byte("base64 lines work too") {
+		ctxt = append(ctxt, b^0x42)
+	}
+	text := "not base64 !!\n\n" + base64.StdEncoding.EncodeToString(ctxt) + "\n"
+
+	top, stats, _ := Scan(strings.NewReader(text), Options{Decode: Input(input.Base64), TopK: 1})
+	if stats.Skipped != 2 || len(top) != 1 || top[0].Line != 3 || top[0].Key != 0x42 {
+		t.Errorf("Unexpected result %+v %+v", stats, top)
+	}
+}
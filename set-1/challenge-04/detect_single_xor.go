@@ -9,107 +9,77 @@ Find it.
 package main
 
 import (
-	"bufio"
-	"encoding/hex"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"runtime"
 	"strconv"
-)
 
-//English frequency from A to Z
-var freq = [...]float64{
-	0.08167, 0.01492, 0.02782, 0.04253, 0.12702, 0.02228, 0.02015,
-	0.06094, 0.06966, 0.00153, 0.00772, 0.04025, 0.02406, 0.06749,
-	0.07507, 0.01929, 0.00095, 0.05987, 0.06327, 0.09056, 0.02758,
-	0.00978, 0.02360, 0.00150, 0.01974, 0.00074,
-}
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-04/detect"
+)
 
 var fileName = "file.txt"
 
-func main() {
-	f, err := os.Open(fileName)
-	check(err)
-
-	defer f.Close()
+var modelFile = flag.String("model", "", "score with a model written by the train command")
 
-	scanner := bufio.NewScanner(f)
-	scanner.Split(bufio.ScanLines)
+var topN = flag.Int("top", 3, "number of lines to report")
 
-	highest := 0.0
-	key := ""
-	pt := ""
+var workers = flag.Int("workers", runtime.NumCPU(), "number of lines brute-forced in parallel")
 
-	for scanner.Scan() {
-		texts, key1, score := Decipher(scanner.Text())
-		if score > highest {
-			highest = score
-			key = key1
-			pt = texts
-		}
-	}
-	fmt.Println("Key => " + key + "\nScore => " + strconv.FormatFloat(highest, 'f', 6, 64) + "\nCiphertext => " + pt)
-}
-
-func check(e error) {
-	if e != nil {
-		panic(e)
-	}
-}
+var encoding = flag.String("enc", "hex", "encoding of the lines: auto, hex, base64, base64url or raw")
 
-//Decipher deciphers a given ciphertext
-func Decipher(cText string) (string, string, float64) {
-	ctxt, _ := hex.DecodeString(cText)
-	plaintext := make([]byte, len(ctxt))
-	txt := ""
-	high := 0.0
-	key := ""
+var verbose = flag.Bool("v", false, "report lines on stderr as they make it into the top")
 
-	//we loop around all possible ASCII characters as one of these character
-	//was used as the key.
-	for i := 0; i < 256; i++ {
+func main() {
+	flag.Parse()
 
-		for k := 0; k < len(ctxt); k++ {
-			plaintext[k] = byte(ctxt[k] ^ byte(i))
-		}
+	opts := detect.Options{Workers: *workers, TopK: *topN, Scorer: score.EnglishWeighted}
+	if *modelFile != "" {
+		m, err := score.LoadFile(*modelFile)
+		check(err)
+		opts.Scorer = m
+	}
 
-		score := getScore(plaintext)
+	enc, err := input.ParseEncoding(*encoding)
+	check(err)
+	opts.Decode = detect.Input(enc)
 
-		//a low score means that the deciphered plaintext is the closest to our expected(english text)
-		if score > high {
-			txt = string(plaintext)
-			high = score
-			key = string(byte(i))
+	//the final ranking is only printed at the end, which can take a while on big dumps
+	if *verbose {
+		opts.Found = func(r detect.Result) {
+			fmt.Fprintf(os.Stderr, "candidate line %d (offset %d) score %f\n", r.Line, r.Offset, r.Score)
 		}
 	}
 
-	return txt, key, high
-}
-
-//get score based on custom scoring function
-func getScore(ctxt []byte) float64 {
+	//lines are streamed through Scan rather than loaded, dumps can be big
+	var r io.Reader = os.Stdin
+	name := fileName
+	if flag.NArg() > 0 {
+		name = flag.Arg(0)
+	}
+	if name != "-" {
+		f, err := os.Open(name)
+		check(err)
+		defer f.Close()
+		r = f
+	}
 
-	var alpCount [26]int
-	validCharCount := 0
+	top, stats, err := detect.Scan(r, opts)
+	check(err)
 
-	//get observed number of occurence for each char in ctxt
-	for _, val := range ctxt {
-		if val >= 0x41 && val <= 0x5A /*for A to Z*/ {
-			val = val + (0x5A - 0x41)
-		}
-		if val >= 0x61 && val <= 0x7A /*for a to z*/ {
-			alpCount[int(val-0x61)]++
-			validCharCount++
-		}
+	fmt.Println("Records => " + strconv.Itoa(stats.Records) + " (" + strconv.Itoa(stats.Skipped) + " skipped)")
+	for _, r := range top {
+		fmt.Println("Line => " + strconv.Itoa(r.Line) + "\nOffset => " + strconv.FormatInt(r.Offset, 10) +
+			"\nKey => " + strconv.Quote(string([]byte{r.Key})) + "\nScore => " + strconv.FormatFloat(r.Score, 'f', 6, 64) +
+			"\nMargin => " + strconv.FormatFloat(r.Margin, 'f', 6, 64) + "\nCiphertext => " + string(r.Plaintext))
 	}
+}
 
-	chi := 0.0
-	//chi squared here will fail
-	for i, val := range alpCount {
-		//The score for a letter is: the expected occurence (freq of letter * length of text) of that
-		//letter multiplied by the observed occurence of that letter within the text.
-		//We can assume that letters with the highest frequency will make the bulk of the sentence,
-		//so if these letter are not present the score will be effectively penalized.
-		chi += freq[i] * float64(validCharCount) * float64(val)
+func check(e error) {
+	if e != nil {
+		panic(e)
 	}
-	return chi
 }
@@ -22,7 +22,12 @@ package main
 
 import (
 	"encoding/hex"
+	"flag"
 	"fmt"
+	"os"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-02/variant"
 )
 
 var key = "ICE"
@@ -30,19 +35,43 @@ var plaintxt = [...]string{`Burning 'em, if you ain't quick and nimble
 I go crazy when I hear a cymbal`,
 }
 
+var variantName = flag.String("variant", "static", "key schedule: static, positional, rotating, incrementing, autokey or ctautokey")
+
+var step = flag.Int("step", 1, "what incrementing adds to the key after each block")
+
 func main() {
-	for _, val := range plaintxt {
-		enTxt := encipher([]byte(val), []byte(key))
-		cipherText := hex.EncodeToString(enTxt)
-		fmt.Println(string(cipherText))
+	flag.Parse()
+
+	c, ok := variant.ByName[*variantName]
+	if !ok {
+		fmt.Println("Error: unknown variant", *variantName)
+		os.Exit(1)
+	}
+	if _, ok := c.(variant.Incrementing); ok {
+		c = variant.Incrementing{Step: byte(*step)}
 	}
-}
 
-func encipher(plaintext []byte, key []byte) []byte {
-	cipherTxt := make([]byte, len(plaintext), len(plaintext))
+	//files given are raw plaintexts
+	texts := plaintxt[:]
+	if flag.NArg() > 0 {
+		records, err := input.Load(flag.Args(), input.Options{Encoding: input.Raw})
+		if err != nil {
+			fmt.Println("Error: ", err)
+			os.Exit(1)
+		}
+		texts = nil
+		for _, r := range records {
+			texts = append(texts, string(r.Data))
+		}
+	}
 
-	for i := range plaintext {
-		cipherTxt[i] = plaintext[i] ^ key[i%len(key)]
+	for _, val := range texts {
+		enTxt, err := c.Encrypt([]byte(val), []byte(key))
+		if err != nil {
+			fmt.Println("Error: ", err)
+			os.Exit(1)
+		}
+		cipherText := hex.EncodeToString(enTxt)
+		fmt.Println(string(cipherText))
 	}
-	return cipherTxt
 }
@@ -54,173 +54,200 @@ the "wokka wokka!!!" edit distance really is 37.
 package main
 
 import (
-	"encoding/base64"
 	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
-	"math"
-	"math/bits"
+	"os"
+	"strconv"
+	"strings"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-02/variant"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-06/repxor"
+	varbreak "cryptopals/set-1/challenge-06/variant"
 )
 
-//from A to Z
-var freq = [...]float64{
-	0.08167, 0.01492, 0.02782, 0.04253, 0.12702, 0.02228, 0.02015,
-	0.06094, 0.06966, 0.00153, 0.00772, 0.04025, 0.02406, 0.06749,
-	0.07507, 0.01929, 0.00095, 0.05987, 0.06327, 0.09056, 0.02758,
-	0.00978, 0.02360, 0.00150, 0.01974, 0.00074,
-}
-
 var fileName = "file.txt"
 
-func main() {
-	file, err := ioutil.ReadFile(fileName)
-	checkErr(err)
-	cipherTxt, err := base64.StdEncoding.DecodeString(string(file))
-	checkErr(err)
-	plaintext := DecipherRepXOR(cipherTxt)
-	fmt.Println(plaintext)
-}
+var encoding = flag.String("enc", "auto", "encoding of the input: auto, hex, base64, base64url or raw")
 
-func checkErr(err error) {
-	if err != nil {
-		fmt.Println("Error: ", err)
-	}
-}
+var modelFile = flag.String("model", "", "score with a model written by the train command")
 
-//DecipherRepXOR given a txt encrypted with a repeating XOR cipher, find the plaintext.
-func DecipherRepXOR(cipherText []byte) string {
-	keysize := guessKeySize(cipherText, 40)
-	key := findKey(cipherText, keysize)
+var estimatorName = flag.String("keysize", "", "key size estimator: hamming, ioc, friedman, kasiski or vote (default hamming, ioc in binary mode)")
 
-	plaintext := make([]byte, len(cipherText))
+var modeName = flag.String("mode", "text", "kind of plaintext: text, binary or auto")
 
-	for i := range plaintext {
-		plaintext[i] = cipherText[i] ^ key[i%len(key)]
-	}
+var magic = flag.String("magic", "", "file format whose magic bytes start the plaintext, e.g. png, zip or pdf")
+
+var crib = flag.String("crib", "", "known plaintext as offset:text, negative offsets count from the end")
 
-	return string(plaintext)
+var estimators = map[string]repxor.Estimator{
+	"hamming":  repxor.ByHamming,
+	"ioc":      repxor.ByCoincidence,
+	"friedman": repxor.Friedman{Kappa: repxor.EnglishKappa},
+	"kasiski":  repxor.Kasiski{MinLen: 3},
+	"vote":     repxor.DefaultVote,
 }
 
-//guessKeys finds the best possible key length.
-func guessKeySize(ciphertext []byte, maxIt int) int {
-	keysize := 2
-	bestscore := math.MaxFloat64
-	bestsize := -1
-
-	for ; keysize <= maxIt; keysize++ {
-		//first and second keywords worth of bytes are in a block that is a multiple of keysize
-		//this is to get a more accurate distance i.e reduce noise and therefore have
-		//more accurate results
-		firstb := ciphertext[:keysize*4]
-		secondb := ciphertext[keysize*4 : keysize*2*4]
-		dst, err := hammingDst(firstb, secondb)
-		checkErr(err)
-		totdst := float64(dst) / float64(keysize)
+var variantName = flag.String("variant", "static", "key schedule: static, positional, rotating, incrementing, autokey, ctautokey or auto to try them all; -keysize, -mode and the cribs only apply to static")
 
-		if totdst < bestscore {
-			bestscore = totdst
-			bestsize = keysize
-		}
-	}
-	return bestsize
+var modes = map[string]repxor.Mode{
+	"text":   repxor.Text,
+	"binary": repxor.Binary,
+	"auto":   repxor.Auto,
 }
 
-//hammingDst calculates the hamming distance between two strings.
-func hammingDst(txt, txt2 []byte) (int, error) {
-	score := 0
+func main() {
+	flag.Parse()
 
-	if len(txt) != len(txt2) {
-		return -1, errors.New("Parameters are not of the same length")
+	mode, ok := modes[*modeName]
+	if !ok {
+		checkErr(errors.New("unknown mode " + *modeName))
+		return
 	}
-
-	for i, x := range txt {
-		//we xor the 2 values since we only care about the bits that are different
-		bitVal := x ^ txt2[i]
-		score += bits.OnesCount8(bitVal)
+	opts := repxor.Options{Mode: mode}
+
+	//columns are not contiguous text so only byte frequencies are worth scoring
+	if *modelFile != "" {
+		m, err := score.LoadFile(*modelFile)
+		if err != nil {
+			checkErr(err)
+			return
+		}
+		opts.Column = m.WithOrder(1)
 	}
 
-	return score, nil
-}
+	if *estimatorName != "" {
+		if opts.Estimator, ok = estimators[*estimatorName]; !ok {
+			checkErr(errors.New("unknown key size estimator " + *estimatorName))
+			return
+		}
+	}
 
-func findKey(ctxt []byte, size int) string {
-	blocks := make([][]byte, len(ctxt)/size)
-	key := ""
+	cribs, err := parseCribs()
+	if err != nil {
+		checkErr(err)
+		return
+	}
+	opts.Cribs = cribs
 
-	//break ciphertext into blocks of keysize
-	for i := 0; i < len(ctxt)/size; i++ {
-		blocks[i] = ctxt[i*size : (i*size)+size]
+	enc, err := input.ParseEncoding(*encoding)
+	if err != nil {
+		checkErr(err)
+		return
+	}
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{fileName}
+	}
+	cipherTxt, err := input.LoadOne(names, enc)
+	if err != nil {
+		checkErr(err)
+		return
 	}
 
-	//Turn each column into a row (transposition of a matrix). Remember we broke our ciphertext into keysize blocks
-	//that means each column in our matrix has been encrypted with the same byte.
-	for i := 0; i < size; i++ {
-		col := make([]byte, len(blocks))
+	if *variantName != "static" {
+		breakVariant(cipherTxt, opts)
+		return
+	}
 
-		for k := 0; k < len(blocks); k++ {
-			col[k] = blocks[k][i]
-		}
-		//We can use our solution to challenge-3/4 to find the key used to encrypt col.
-		_, subKey, _ := Decipher(col)
-		//build the key from each successfull col decipher
-		key += subKey
+	report, err := DecipherRepXOR(cipherTxt, opts)
+	if err != nil {
+		checkErr(err)
+		return
 	}
 
-	return key
+	for _, alt := range report.Alternatives {
+		fmt.Fprintf(os.Stderr, "rejected key size %d (score %f): %q\n", alt.KeySize, alt.Score, alt.Key)
+	}
+	if format := repxor.Identify(report.Plaintext); format != "" {
+		fmt.Fprintf(os.Stderr, "plaintext is a valid %s file\n", format)
+	}
+	fmt.Printf("Key size => %d\nKey => %q\n%s", report.KeySize, report.Key, report.Plaintext)
 }
 
-//Decipher deciphers a given ciphertext
-func Decipher(ctxt []byte) (string, string, float64) {
-	plaintext := make([]byte, len(ctxt))
-	txt := ""
-	high := 0.0
-	key := ""
-
-	//we loop around all possible ASCII characters as one of these character
-	// was used as the key.
-	for i := 0; i < 256; i++ {
+func checkErr(err error) {
+	if err != nil {
+		fmt.Println("Error: ", err)
+	}
+}
 
-		for k := 0; k < len(ctxt); k++ {
-			plaintext[k] = byte(ctxt[k] ^ byte(i))
-		}
+//DecipherRepXOR given a txt encrypted with a repeating XOR cipher, find the plaintext.
+//The best key sizes are guessed with opts.Estimator, each column of the key is solved
+//with opts.Column unless cribs give it away, and the hypothesis with the most
+//plausible plaintext wins. Zero options break English text.
+func DecipherRepXOR(cipherText []byte, opts repxor.Options) (*repxor.Report, error) {
+	return repxor.Break(cipherText, opts)
+}
 
-		score := getScore(plaintext)
+//breakVariant breaks cipherTxt encrypted with the variant named by the -variant flag
+func breakVariant(cipherTxt []byte, opts repxor.Options) {
+	vopts := varbreak.Options{Column: opts.Column}
+	if c, ok := variant.ByName[*variantName]; ok {
+		vopts.Ciphers = []variant.Cipher{c}
+	} else if *variantName != "auto" {
+		checkErr(errors.New("unknown variant " + *variantName))
+		return
+	}
 
-		//a low score means that the deciphered plaintext is the closest to our expected(english text)
-		if score > high {
-			txt = string(plaintext)
-			high = score
-			key = string(byte(i))
-		}
+	results, err := varbreak.Detect(cipherTxt, vopts)
+	if err != nil {
+		checkErr(err)
+		return
 	}
 
-	return txt, key, high
+	for _, alt := range results[1:] {
+		fmt.Fprintf(os.Stderr, "rejected %s (score %f): %q\n", alt.Cipher.Name(), alt.Score, alt.Key)
+	}
+	best := results[0]
+	fmt.Printf("Variant => %s\nKey size => %d\nKey => %q\n%s", best.Cipher.Name(), len(best.Key), best.Key, best.Plaintext)
 }
 
-//get score
-func getScore(ctxt []byte) float64 {
+//parseCribs turns the -magic and -crib flags into cribs
+func parseCribs() ([]repxor.Crib, error) {
+	var cribs []repxor.Crib
 
-	var alpCount [26]int
-	validCharCount := 0
+	if *magic != "" {
+		c, ok := repxor.Magic[*magic]
+		if !ok {
+			return nil, errors.New("unknown file format " + *magic)
+		}
+		cribs = append(cribs, c)
+	}
 
-	//get observed number of occurence for each char in ctxt
-	for _, val := range ctxt {
-		if val >= 0x41 && val <= 0x5A /*for A to Z*/ {
-			val = val + (0x5A - 0x41)
+	if *crib != "" {
+		parts := strings.SplitN(*crib, ":", 2)
+		if len(parts) != 2 {
+			return nil, errors.New("crib must be offset:text")
 		}
-		if val >= 0x61 && val <= 0x7A /*for a to z*/ {
-			alpCount[int(val-0x61)]++
-			validCharCount++
+		off, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, err
 		}
+		cribs = append(cribs, repxor.Crib{Offset: off, Text: []byte(parts[1])})
 	}
 
-	chi := 0.0
-	//chi square here will fail
-	for i, val := range alpCount {
-		//The score for a letter is: the expected occurence (freq of letter * length of text) of that
-		//letter multiplied by the observed occurence of that letter within the text.
-		//We can assume that letters with the highest frequency will make the bulk of the sentence,
-		//so if these letter are not present the score will be effectively penalized.
-		chi += freq[i] * float64(validCharCount) * float64(val)
+	return cribs, nil
+}
+
+//guessKeySize finds the best possible key length up to maxIt according to e.
+func guessKeySize(ciphertext []byte, maxIt int, e repxor.Estimator) (int, error) {
+	sizes, err := e.KeySizes(ciphertext, 2, maxIt)
+	if err != nil {
+		return -1, err
 	}
-	return chi
+
+	return sizes[0].Size, nil
+}
+
+//hammingDst calculates the hamming distance between two strings.
+func hammingDst(txt, txt2 []byte) (int, error) {
+	return repxor.Hamming(txt, txt2)
+}
+
+//findKey recovers a key of the given size, solving each column with s.
+func findKey(ctxt []byte, size int, s score.Scorer) string {
+	key, _ := repxor.FindKey(ctxt, size, s)
+	return string(key)
 }
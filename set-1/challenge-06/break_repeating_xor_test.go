@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"testing"
+
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-06/repxor"
 )
 
 func TestHaming(t *testing.T) {
@@ -24,15 +27,51 @@ func TestHaming(t *testing.T) {
 func TestGuessKeys(t *testing.T) {
 	file, _ := ioutil.ReadFile("file.txt")
 	cipherTxt, _ := base64.StdEncoding.DecodeString(string(file))
-	distances := guessKeySize(cipherTxt, 40)
+	distances, err := guessKeySize(cipherTxt, 40, repxor.ByHamming)
 	fmt.Println(distances)
 
+	if err != nil || distances != 29 {
+		t.Errorf("Expected 29, got %d (%v)", distances, err)
+	}
+
+	//too short for even one pair of 2 byte blocks, this used to panic
+	if _, err := guessKeySize(cipherTxt[:3], 40, repxor.ByHamming); err == nil {
+		t.Errorf("Expected an error for a 3 byte ciphertext")
+	}
+
 }
 
 func TestFindKey(t *testing.T) {
 	file, _ := ioutil.ReadFile("file.txt")
 	cipherTxt, _ := base64.StdEncoding.DecodeString(string(file))
-	distances := guessKeySize(cipherTxt, 40)
-	key := findKey(cipherTxt, distances)
+	distances, _ := guessKeySize(cipherTxt, 40, repxor.ByHamming)
+	key := findKey(cipherTxt, distances, score.EnglishWeighted)
 	fmt.Println(key)
 }
+
+//with only 8 bytes per column the byte model should recover more of the key than
+//the letter weighted score
+func TestFindKeyShortColumns(t *testing.T) {
+	file, _ := ioutil.ReadFile("file.txt")
+	cipherTxt, _ := base64.StdEncoding.DecodeString(string(file))
+	truth := findKey(cipherTxt, 29, score.EnglishWeighted)
+
+	correct := func(s score.Scorer) int {
+		n := 0
+		for off := 0; off+29*8 <= len(cipherTxt); off += 29 * 8 {
+			key := findKey(cipherTxt[off:off+29*8], 29, s)
+			for i := range key {
+				if key[i] == truth[i] {
+					n++
+				}
+			}
+		}
+		return n
+	}
+
+	weighted := correct(score.EnglishWeighted)
+	model := correct(score.EnglishModel().WithOrder(1))
+	if model <= weighted {
+		t.Errorf("Expected the byte model to beat %d correct key bytes, got %d", weighted, model)
+	}
+}
@@ -0,0 +1,109 @@
+package classical
+
+import (
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-06/repxor"
+)
+
+//KeySizes ranks key sizes from min to max with repxor.CoincidenceKeySizes, counting
+//only the symbols of the alphabet. Combining with a single key symbol only relabels
+//the symbols of a column, whatever the operation.
+func (c Cipher) KeySizes(text []byte, min, max int) ([]repxor.KeySize, error) {
+	if c.KeyLen != 0 {
+		min, max = c.KeyLen, c.KeyLen
+	}
+	return repxor.CoincidenceKeySizes(c.symbols(text), min, max)
+}
+
+//FindKey recovers a key of the given size by trying every key symbol on each column
+//and keeping the one whose plaintext s scores best. Letters are scored in lower case.
+func (c Cipher) FindKey(text []byte, size int, s score.Scorer) []int {
+	return ints(c.findKey(text, size, s))
+}
+
+//findKey is FindKey with the key symbols as bytes, as repxor has them
+func (c Cipher) findKey(text []byte, size int, s score.Scorer) []byte {
+	a, n := c.Alphabet, c.Alphabet.Len()
+	decrypt := func(x, k byte) byte { return a.Symbol(c.Op.Decrypt(int(x), int(k), n), 'a') }
+	return repxor.FindKeyOp(c.symbols(text), size, c.keyAlphabet().Len(), decrypt, s)
+}
+
+func ints(key []byte) []int {
+	out := make([]int, len(key))
+	for i, k := range key {
+		out[i] = int(k)
+	}
+	return out
+}
+
+//Options configures Break. Zero fields get the defaults documented on each.
+type Options struct {
+	//MinSize and MaxSize bound the key sizes tried, 1 and 20 by default
+	MinSize, MaxSize int
+	//Candidates is the number of key sizes to recover a key for, 3 by default
+	Candidates int
+	//Column scores the candidates for a single key symbol, the English byte model by
+	//default
+	Column score.Scorer
+	//Text scores the full plaintext, the English quadgram model by default
+	Text score.Scorer
+}
+
+func (o *Options) defaults() {
+	if o.MinSize == 0 {
+		o.MinSize = 1
+	}
+	if o.MaxSize == 0 {
+		o.MaxSize = 20
+	}
+	if o.Candidates == 0 {
+		o.Candidates = 3
+	}
+	if o.Column == nil {
+		o.Column = score.EnglishModel().WithOrder(1)
+	}
+	if o.Text == nil {
+		o.Text = score.EnglishModel()
+	}
+}
+
+//Result is the key and plaintext Break settled on
+type Result struct {
+	Key       []int
+	Plaintext []byte
+	//Score is what Options.Text gave Plaintext
+	Score float64
+}
+
+//Break recovers the key and plaintext of text. It solves the best few key sizes by
+//index of coincidence and keeps the plaintext that scores best. A key that is a
+//shorter key repeated is cut down to it, multiples of the key size fit equally well.
+func (c Cipher) Break(text []byte, opts Options) (*Result, error) {
+	opts.defaults()
+
+	sizes, err := c.KeySizes(text, opts.MinSize, opts.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(sizes) > opts.Candidates {
+		sizes = sizes[:opts.Candidates]
+	}
+
+	var best *Result
+	for _, ks := range sizes {
+		key := c.findKey(text, ks.Size, opts.Column)
+		key = key[:repxor.Period(key, 1, 0)]
+
+		pt, err := c.Decrypt(text, ints(key))
+		if err != nil {
+			return nil, err
+		}
+
+		res := &Result{Key: ints(key), Plaintext: pt, Score: opts.Text.Score(pt)}
+		if best == nil || res.Score > best.Score || (res.Score == best.Score && len(key) < len(best.Key)) {
+			best = res
+		}
+	}
+
+	return best, nil
+}
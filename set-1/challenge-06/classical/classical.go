@@ -0,0 +1,231 @@
+//Package classical breaks the pen and paper polyalphabetic ciphers the same way repxor
+//breaks repeating-key XOR: rank key sizes by the index of coincidence of their
+//columns, then solve each column on its own. Only the alphabet and the operation that
+//combines a plaintext symbol with a key symbol change, so both are parameters and
+//Caesar, Vigenère, Beaufort and Gronsfeld are just settings of them. XOR over all 256
+//byte values fits too, repxor is the faster special case of it. Monoalphabetic
+//substitution has no key size to find and is solved by hill climbing instead, as are
+//the column orders of transposition ciphers too wide to try them all.
+package classical
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Alphabet numbers the symbols a cipher works on. Bytes outside of it pass through
+//encryption unchanged and do not use up key.
+type Alphabet struct {
+	symbols []byte
+	index   [256]int
+	//fold maps lower case ASCII onto the upper case symbols, keeping the case
+	fold bool
+}
+
+//NewAlphabet numbers symbols in order. With fold, lower case ASCII letters are read
+//as the upper case symbols and written back in lower case.
+func NewAlphabet(symbols string, fold bool) (*Alphabet, error) {
+	if len(symbols) < 2 {
+		return nil, errors.New("classical: alphabet needs at least two symbols")
+	}
+
+	a := &Alphabet{symbols: []byte(symbols), fold: fold}
+	for i := range a.index {
+		a.index[i] = -1
+	}
+	for i, b := range a.symbols {
+		if a.index[b] >= 0 {
+			return nil, fmt.Errorf("classical: %q appears twice in the alphabet", b)
+		}
+		a.index[b] = i
+	}
+
+	return a, nil
+}
+
+//mustAlphabet is NewAlphabet for the alphabets defined here
+func mustAlphabet(symbols string, fold bool) *Alphabet {
+	a, err := NewAlphabet(symbols, fold)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+var (
+	//Latin is A to Z, reading lower case as upper case
+	Latin = mustAlphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ", true)
+	//Digits is 0 to 9, the key alphabet of Gronsfeld
+	Digits = mustAlphabet("0123456789", false)
+	//Bytes is every byte value, for XOR
+	Bytes = mustAlphabet(string(allBytes()), false)
+)
+
+func allBytes() []byte {
+	b := make([]byte, 256)
+	for i := range b {
+		b[i] = byte(i)
+	}
+	return b
+}
+
+//Len returns the number of symbols
+func (a *Alphabet) Len() int {
+	return len(a.symbols)
+}
+
+//Index returns the number of b and whether b is in the alphabet
+func (a *Alphabet) Index(b byte) (int, bool) {
+	if a.fold && b >= 'a' && b <= 'z' && a.index[b] < 0 {
+		b -= 'a' - 'A'
+	}
+	i := a.index[b]
+	return i, i >= 0
+}
+
+//Symbol returns symbol i, in lower case when like is a lower case letter and the
+//alphabet folds
+func (a *Alphabet) Symbol(i int, like byte) byte {
+	b := a.symbols[i]
+	if a.fold && like >= 'a' && like <= 'z' && b >= 'A' && b <= 'Z' {
+		b += 'a' - 'A'
+	}
+	return b
+}
+
+//Op combines a plaintext symbol p and a key symbol k into a ciphertext symbol and back,
+//all as indices into an alphabet of n symbols
+type Op struct {
+	Encrypt func(p, k, n int) int
+	Decrypt func(c, k, n int) int
+}
+
+var (
+	//Add shifts forward by the key: Caesar, Vigenère and Gronsfeld
+	Add = Op{
+		Encrypt: func(p, k, n int) int { return (p + k) % n },
+		Decrypt: func(c, k, n int) int { return (c - k%n + n) % n },
+	}
+	//Subtract from the key, the Beaufort cipher. It is its own inverse.
+	Subtract = Op{
+		Encrypt: func(p, k, n int) int { return (k - p%n + n) % n },
+		Decrypt: func(c, k, n int) int { return (k - c%n + n) % n },
+	}
+	//XOR is the operation of repxor, for alphabets whose size is a power of two
+	XOR = Op{
+		Encrypt: func(p, k, n int) int { return p ^ k },
+		Decrypt: func(c, k, n int) int { return c ^ k },
+	}
+)
+
+//Cipher is a polyalphabetic cipher: symbol i of the text is combined with symbol
+//i%len(key) of the key, counting only the symbols in Alphabet.
+type Cipher struct {
+	Name     string
+	Alphabet *Alphabet
+	//KeyAlphabet spells keys, Alphabet when nil. Key symbols are used by index.
+	KeyAlphabet *Alphabet
+	Op          Op
+	//KeyLen fixes the length of the key when not zero, 1 for Caesar
+	KeyLen int
+}
+
+var (
+	//Caesar shifts every letter by the same amount
+	Caesar = Cipher{Name: "Caesar", Alphabet: Latin, Op: Add, KeyLen: 1}
+	//Vigenere shifts each letter by the matching letter of the key
+	Vigenere = Cipher{Name: "Vigenère", Alphabet: Latin, Op: Add}
+	//Beaufort subtracts each letter from the matching letter of the key
+	Beaufort = Cipher{Name: "Beaufort", Alphabet: Latin, Op: Subtract}
+	//Gronsfeld is Vigenère with a key of digits
+	Gronsfeld = Cipher{Name: "Gronsfeld", Alphabet: Latin, KeyAlphabet: Digits, Op: Add}
+	//RepeatingXOR is repeating-key XOR over bytes
+	RepeatingXOR = Cipher{Name: "repeating-key XOR", Alphabet: Bytes, Op: XOR}
+)
+
+func (c Cipher) keyAlphabet() *Alphabet {
+	if c.KeyAlphabet != nil {
+		return c.KeyAlphabet
+	}
+	return c.Alphabet
+}
+
+//ErrKey is returned for keys that are empty, of the wrong length or spelt with
+//symbols outside of the key alphabet
+var ErrKey = errors.New("classical: invalid key")
+
+//ParseKey turns a key spelt in the key alphabet into the indices Encrypt takes
+func (c Cipher) ParseKey(key string) ([]int, error) {
+	if key == "" || (c.KeyLen != 0 && len(key) != c.KeyLen) {
+		return nil, ErrKey
+	}
+
+	ka := c.keyAlphabet()
+	out := make([]int, len(key))
+	for i := range key {
+		k, ok := ka.Index(key[i])
+		if !ok {
+			return nil, fmt.Errorf("classical: %q is not in the key alphabet", key[i])
+		}
+		out[i] = k
+	}
+	return out, nil
+}
+
+//FormatKey spells key in the key alphabet
+func (c Cipher) FormatKey(key []int) string {
+	ka := c.keyAlphabet()
+	out := make([]byte, len(key))
+	for i, k := range key {
+		out[i] = ka.Symbol(k, 0)
+	}
+	return string(out)
+}
+
+//Encrypt encrypts text with key, given as indices into the key alphabet
+func (c Cipher) Encrypt(text []byte, key []int) ([]byte, error) {
+	return c.apply(text, key, c.Op.Encrypt)
+}
+
+//Decrypt decrypts text with key, given as indices into the key alphabet
+func (c Cipher) Decrypt(text []byte, key []int) ([]byte, error) {
+	return c.apply(text, key, c.Op.Decrypt)
+}
+
+func (c Cipher) apply(text []byte, key []int, op func(x, k, n int) int) ([]byte, error) {
+	if len(key) == 0 || (c.KeyLen != 0 && len(key) != c.KeyLen) {
+		return nil, ErrKey
+	}
+	for _, k := range key {
+		if k < 0 || k >= c.keyAlphabet().Len() {
+			return nil, ErrKey
+		}
+	}
+
+	a, n := c.Alphabet, c.Alphabet.Len()
+	out := make([]byte, len(text))
+	j := 0
+	for i, b := range text {
+		x, ok := a.Index(b)
+		if !ok {
+			out[i] = b
+			continue
+		}
+		out[i] = a.Symbol(op(x, key[j%len(key)], n), b)
+		j++
+	}
+
+	return out, nil
+}
+
+//symbols returns the indices of the symbols of text that are in the alphabet, as
+//bytes for repxor: an alphabet has at most 256 symbols
+func (c Cipher) symbols(text []byte) []byte {
+	out := make([]byte, 0, len(text))
+	for _, b := range text {
+		if x, ok := c.Alphabet.Index(b); ok {
+			out = append(out, byte(x))
+		}
+	}
+	return out
+}
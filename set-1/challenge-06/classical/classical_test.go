@@ -0,0 +1,190 @@
+package classical
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+//plaintext borrowed from the challenge 6 description
+const sample = `This challenge isn't conceptually hard, but it involves actual error-prone
+coding. The other challenges in this set are there to bring you up to speed.
+This one is there to qualify you. If you can do this one, you're probably just
+fine up to Set 6. There's a file here. It's been base64'd after being encrypted
+with repeating-key XOR. Decrypt it. Breaking repeating-key XOR ("Vigenere")
+statistically is obviously an academic exercise, a "Crypto 101" thing. But more
+people "know how" to break it than can actually break it, and a similar technique
+breaks something much more important.`
+
+func TestKnownAnswers(t *testing.T) {
+	tests := []struct {
+		c      Cipher
+		key    string
+		pt, ct string
+	}{
+		{Vigenere, "LEMON", "ATTACKATDAWN", "LXFOPVEFRNHR"},
+		{Vigenere, "LEMON", "Attack at dawn!", "Lxfopv ef rnhr!"},
+		{Caesar, "D", "the quick brown fox", "wkh txlfn eurzq ira"},
+		{Beaufort, "FORTIFICATION", "DEFENDTHEEASTWALLOFTHECASTLE", "CKMPVCPVWPIWUJOGIUAPVWRIWUUK"},
+		{Gronsfeld, "31415", "GRONSFELD", "JSSOXIFPE"},
+	}
+
+	for _, tt := range tests {
+		key, err := tt.c.ParseKey(tt.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ct, err := tt.c.Encrypt([]byte(tt.pt), key)
+		if err != nil || string(ct) != tt.ct {
+			t.Errorf("%s: expected %q, got %q (%v)", tt.c.Name, tt.ct, ct, err)
+		}
+		pt, err := tt.c.Decrypt(ct, key)
+		if err != nil || string(pt) != tt.pt {
+			t.Errorf("%s: expected %q back, got %q (%v)", tt.c.Name, tt.pt, pt, err)
+		}
+	}
+
+	if _, err := Caesar.ParseKey("AB"); err != ErrKey {
+		t.Errorf("Expected %v, got %v", ErrKey, err)
+	}
+	if _, err := Gronsfeld.ParseKey("12A"); err == nil {
+		t.Errorf("Expected an error for a letter in a Gronsfeld key")
+	}
+}
+
+func TestBreak(t *testing.T) {
+	tests := []struct {
+		c   Cipher
+		key string
+	}{
+		{Caesar, "K"},
+		{Vigenere, "CRYPTO"},
+		{Beaufort, "KEYWORD"},
+		{Gronsfeld, "31415"},
+		{RepeatingXOR, "ICE"},
+	}
+
+	for _, tt := range tests {
+		key, _ := tt.c.ParseKey(tt.key)
+		ct, _ := tt.c.Encrypt([]byte(sample), key)
+
+		res, err := tt.c.Break(ct, Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tt.c.FormatKey(res.Key); got != tt.key || !bytes.Equal(res.Plaintext, []byte(sample)) {
+			t.Errorf("%s: expected %q, got %q", tt.c.Name, tt.key, got)
+		}
+	}
+}
+
+func TestSubstitution(t *testing.T) {
+	const key = "QWERTYUIOPASDFGHJKLZXCVBNM"
+
+	ct, err := SubstitutionEncrypt([]byte(sample), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pt, _ := SubstitutionDecrypt(ct, key); string(pt) != sample {
+		t.Fatalf("Expected the sample back, got %q", pt)
+	}
+	if _, err := SubstitutionEncrypt(ct, "ABC"); err != ErrKey {
+		t.Errorf("Expected %v, got %v", ErrKey, err)
+	}
+
+	for _, opts := range []SubstitutionOptions{{}, {Temperature: 0.02, Restarts: 2, Iterations: 20000}} {
+		res, err := SolveSubstitution(ct, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res.Plaintext) != sample {
+			t.Errorf("Expected the sample, got %q with key %s", res.Plaintext, res.Key)
+		}
+	}
+
+	//fixed mappings are kept even when wrong
+	res, err := SolveSubstitution(ct, SubstitutionOptions{Fixed: map[byte]byte{'Z': 'q'}, Restarts: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Key[16] != 'Z' {
+		t.Errorf("Expected Q to encrypt to Z, got key %s", res.Key)
+	}
+	if _, err := SolveSubstitution(ct, SubstitutionOptions{Fixed: map[byte]byte{'A': 'e', 'B': 'e'}}); err == nil {
+		t.Errorf("Expected an error for two letters fixed to e")
+	}
+	if _, err := SolveSubstitution(ct, SubstitutionOptions{Fixed: map[byte]byte{'Z': 'q', 'z': 'e'}}); err == nil {
+		t.Errorf("Expected an error for Z fixed to both q and e")
+	}
+	if _, err := SolveSubstitution(ct, SubstitutionOptions{Fixed: map[byte]byte{'Z': 'q', 'z': 'Q'}, Restarts: 1}); err != nil {
+		t.Errorf("Expected Z and z fixed to q to agree, got %v", err)
+	}
+}
+
+func TestTransposition(t *testing.T) {
+	const pt = "WEAREDISCOVEREDFLEEATONCE"
+
+	if got := ColumnarKey("ZEBRAS"); fmt.Sprint(got) != "[5 2 1 3 0 4]" {
+		t.Errorf("Expected [5 2 1 3 0 4], got %v", got)
+	}
+
+	ct, err := ColumnarEncrypt([]byte(pt), ColumnarKey("ZEBRAS"))
+	if err != nil || string(ct) != "EVLNACDTESEAROFODEECWIREE" {
+		t.Errorf("Expected EVLNACDTESEAROFODEECWIREE, got %q (%v)", ct, err)
+	}
+	if back, _ := ColumnarDecrypt(ct, ColumnarKey("ZEBRAS")); string(back) != pt {
+		t.Errorf("Expected %q back, got %q", pt, back)
+	}
+
+	ct, err = RailFenceEncrypt([]byte(pt), 3)
+	if err != nil || string(ct) != "WECRLTEERDSOEEFEAOCAIVDEN" {
+		t.Errorf("Expected WECRLTEERDSOEEFEAOCAIVDEN, got %q (%v)", ct, err)
+	}
+	if back, _ := RailFenceDecrypt(ct, 3); string(back) != pt {
+		t.Errorf("Expected %q back, got %q", pt, back)
+	}
+
+	if _, err := ColumnarEncrypt([]byte(pt), []int{0, 0}); err != ErrWidth {
+		t.Errorf("Expected %v, got %v", ErrWidth, err)
+	}
+}
+
+func TestBreakTransposition(t *testing.T) {
+	for _, key := range []string{"ZEBRAS", "TRAPDOOR"} {
+		ct, _ := ColumnarEncrypt([]byte(sample), ColumnarKey(key))
+		res, err := BreakTransposition(ct, TranspositionOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res.Plaintext) != sample || fmt.Sprint(res.Order) != fmt.Sprint(ColumnarKey(key)) {
+			t.Errorf("Expected %s, got order %v and %q", key, res.Order, res.Plaintext)
+		}
+	}
+
+	ct, _ := RailFenceEncrypt([]byte(sample), 4)
+	res, err := BreakTransposition(ct, TranspositionOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Rails != 4 || string(res.Plaintext) != sample {
+		t.Errorf("Expected 4 rails, got %d", res.Rails)
+	}
+
+	//too short or too narrow for any key is an error, never a nil result
+	for _, tt := range []struct {
+		ct   string
+		opts TranspositionOptions
+	}{
+		{"ab", TranspositionOptions{}},
+		{"abc", TranspositionOptions{}},
+		{sample, TranspositionOptions{MaxWidth: 1}},
+		{sample, TranspositionOptions{MaxWidth: -3}},
+	} {
+		if res, err := BreakTransposition([]byte(tt.ct), tt.opts); err != ErrWidth || res != nil {
+			t.Errorf("%q %+v: expected %v, got %v", tt.ct, tt.opts, ErrWidth, err)
+		}
+	}
+	if res, err := BreakRailFence([]byte("abc"), TranspositionOptions{}); err != nil || res == nil {
+		t.Errorf("Expected 3 bytes to fit two rails, got %v", err)
+	}
+}
@@ -0,0 +1,252 @@
+package classical
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//SubstitutionEncrypt encrypts text with a monoalphabetic substitution over Latin,
+//keeping the case of letters and leaving the other bytes alone. The key is written as
+//the ciphertext letters of A to Z in order, e.g. "QWERTYUIOPASDFGHJKLZXCVBNM" turns A
+//into Q and B into W.
+func SubstitutionEncrypt(text []byte, key string) ([]byte, error) {
+	enc, err := parseSubstitution(key)
+	if err != nil {
+		return nil, err
+	}
+	return substitute(text, enc[:]), nil
+}
+
+//SubstitutionDecrypt decrypts text with a substitution key
+func SubstitutionDecrypt(text []byte, key string) ([]byte, error) {
+	enc, err := parseSubstitution(key)
+	if err != nil {
+		return nil, err
+	}
+	dec := invert(enc)
+	return substitute(text, dec[:]), nil
+}
+
+//parseSubstitution checks key is a permutation of A to Z and returns it as indices
+func parseSubstitution(key string) ([26]int, error) {
+	var enc [26]int
+	var seen [26]bool
+
+	if len(key) != 26 {
+		return enc, ErrKey
+	}
+	for p := range key {
+		c, ok := Latin.Index(key[p])
+		if !ok || seen[c] {
+			return enc, ErrKey
+		}
+		seen[c] = true
+		enc[p] = c
+	}
+
+	return enc, nil
+}
+
+//invert returns the inverse permutation of m
+func invert(m [26]int) [26]int {
+	var inv [26]int
+	for i, j := range m {
+		inv[j] = i
+	}
+	return inv
+}
+
+//substitute replaces each letter x of text with letter m[x]
+func substitute(text []byte, m []int) []byte {
+	out := make([]byte, len(text))
+	for i, b := range text {
+		if x, ok := Latin.Index(b); ok {
+			out[i] = Latin.Symbol(m[x], b)
+		} else {
+			out[i] = b
+		}
+	}
+	return out
+}
+
+//SubstitutionOptions configures SolveSubstitution. Zero fields get the defaults
+//documented on each.
+type SubstitutionOptions struct {
+	//Fixed maps ciphertext letters to the plaintext letters they are known to stand
+	//for. The solver never changes them.
+	Fixed map[byte]byte
+	//Restarts is the number of climbs, 5 by default. The first starts from the key
+	//that matches letter frequencies, the others from a random shuffle of it.
+	Restarts int
+	//Iterations is the number of swaps tried per climb, 5000 by default. Hill
+	//climbing stops early once a tenth of them in a row brought nothing.
+	Iterations int
+	//Temperature turns on simulated annealing when not zero: a swap that lowers the
+	//score by d is still taken with probability exp(d/T), T falling linearly from
+	//Temperature to zero over the climb. Scores are in log10 per byte, 0.02 is a
+	//sensible start.
+	Temperature float64
+	//Text is the fitness of a plaintext, the English quadgram model by default
+	Text score.Scorer
+	//Seed seeds the random swaps so that runs can be repeated
+	Seed int64
+}
+
+func (o *SubstitutionOptions) defaults() {
+	if o.Restarts == 0 {
+		o.Restarts = 5
+	}
+	if o.Iterations == 0 {
+		o.Iterations = 5000
+	}
+	if o.Text == nil {
+		o.Text = score.EnglishModel()
+	}
+}
+
+//SubstitutionResult is the outcome of SolveSubstitution
+type SubstitutionResult struct {
+	//Key is the encryption key as written for SubstitutionEncrypt
+	Key       string
+	Plaintext []byte
+	//Score is what SubstitutionOptions.Text gave Plaintext
+	Score float64
+}
+
+//SolveSubstitution recovers the key of a monoalphabetic substitution. It starts from
+//the key that gives the ciphertext letters their English frequency rank and climbs on
+//the fitness of the plaintext by swapping the plaintext letters of two ciphertext
+//letters.
+func SolveSubstitution(ctxt []byte, opts SubstitutionOptions) (*SubstitutionResult, error) {
+	opts.defaults()
+
+	//dec maps ciphertext letters to plaintext letters, free lists the ones to move
+	var dec [26]int
+	var fixed, used [26]bool
+	for c, p := range opts.Fixed {
+		ci, ok1 := Latin.Index(c)
+		pi, ok2 := Latin.Index(p)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("classical: fixed mapping %q to %q is not between letters", c, p)
+		}
+		if fixed[ci] && dec[ci] != pi {
+			return nil, errors.New("classical: fixed mappings give a ciphertext letter two plaintext letters")
+		}
+		if used[pi] && !fixed[ci] {
+			return nil, errors.New("classical: fixed mappings give two ciphertext letters the same plaintext letter")
+		}
+		dec[ci], fixed[ci], used[pi] = pi, true, true
+	}
+
+	frequencyStart(ctxt, &dec, fixed, used)
+
+	var free []int
+	for c := range dec {
+		if !fixed[c] {
+			free = append(free, c)
+		}
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	fitness := func(d *[26]int) float64 { return opts.Text.Score(substitute(ctxt, d[:])) }
+
+	best, bestScore := dec, fitness(&dec)
+	for r := 0; r < opts.Restarts; r++ {
+		cur := dec
+		if r > 0 {
+			rng.Shuffle(len(free), func(i, j int) {
+				cur[free[i]], cur[free[j]] = cur[free[j]], cur[free[i]]
+			})
+		}
+
+		cur = climb(cur, free, fitness, rng, opts)
+		if sc := fitness(&cur); sc > bestScore {
+			best, bestScore = cur, sc
+		}
+	}
+
+	enc := invert(best)
+	key := make([]byte, 26)
+	for p, c := range enc {
+		key[p] = Latin.Symbol(c, 0)
+	}
+
+	return &SubstitutionResult{Key: string(key), Plaintext: substitute(ctxt, best[:]), Score: bestScore}, nil
+}
+
+//frequencyStart maps the ciphertext letters that are not fixed to the plaintext
+//letters that are not used, most frequent to most frequent
+func frequencyStart(ctxt []byte, dec *[26]int, fixed, used [26]bool) {
+	var counts [26]int
+	for _, b := range ctxt {
+		if x, ok := Latin.Index(b); ok {
+			counts[x]++
+		}
+	}
+
+	var cipher, plain []int
+	for i := 0; i < 26; i++ {
+		if !fixed[i] {
+			cipher = append(cipher, i)
+		}
+		if !used[i] {
+			plain = append(plain, i)
+		}
+	}
+
+	sort.SliceStable(cipher, func(i, j int) bool { return counts[cipher[i]] > counts[cipher[j]] })
+	sort.SliceStable(plain, func(i, j int) bool { return score.English[plain[i]] > score.English[plain[j]] })
+
+	for i, c := range cipher {
+		dec[c] = plain[i]
+	}
+}
+
+//climb swaps pairs of free letters in dec, keeping the swaps that improve the fitness
+//or, when annealing, that the temperature allows
+func climb(dec [26]int, free []int, fitness func(*[26]int) float64, rng *rand.Rand, opts SubstitutionOptions) [26]int {
+	if len(free) < 2 {
+		return dec
+	}
+
+	cur := fitness(&dec)
+	best, bestScore := dec, cur
+	stale := 0
+
+	for it := 0; it < opts.Iterations; it++ {
+		a, b := free[rng.Intn(len(free))], free[rng.Intn(len(free))]
+		if a == b {
+			continue
+		}
+
+		dec[a], dec[b] = dec[b], dec[a]
+		sc := fitness(&dec)
+
+		accept := sc > cur
+		if !accept && opts.Temperature > 0 {
+			t := opts.Temperature * (1 - float64(it)/float64(opts.Iterations))
+			accept = t > 0 && rng.Float64() < math.Exp((sc-cur)/t)
+		}
+
+		if !accept {
+			dec[a], dec[b] = dec[b], dec[a]
+			stale++
+			if opts.Temperature == 0 && stale > opts.Iterations/10 {
+				break
+			}
+			continue
+		}
+
+		cur, stale = sc, 0
+		if cur > bestScore {
+			best, bestScore = dec, cur
+		}
+	}
+
+	return best
+}
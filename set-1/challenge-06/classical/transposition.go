@@ -0,0 +1,362 @@
+package classical
+
+import (
+	"errors"
+	"math/rand"
+	"sort"
+
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//ErrWidth is returned for a columnar key or rail count that does not fit the text
+var ErrWidth = errors.New("classical: invalid transposition width")
+
+//ColumnarKey turns a keyword into a column order: the columns are read in the
+//alphabetical order of the letters of the keyword, left to right among equal letters.
+//ZEBRAS gives [5 2 1 3 0 4], column 4 is read first.
+func ColumnarKey(word string) []int {
+	idx := make([]int, len(word))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return word[idx[i]] < word[idx[j]] })
+
+	order := make([]int, len(word))
+	for rank, col := range idx {
+		order[col] = rank
+	}
+	return order
+}
+
+//checkOrder checks order is a permutation of 0 to len(order)-1
+func checkOrder(order []int) error {
+	seen := make([]bool, len(order))
+	for _, r := range order {
+		if r < 0 || r >= len(order) || seen[r] {
+			return ErrWidth
+		}
+		seen[r] = true
+	}
+	if len(order) == 0 {
+		return ErrWidth
+	}
+	return nil
+}
+
+//readOrder returns the columns in the order they are read
+func readOrder(order []int) []int {
+	cols := make([]int, len(order))
+	for c, r := range order {
+		cols[r] = c
+	}
+	return cols
+}
+
+//ColumnarEncrypt writes text in rows of len(order) and reads it out column by column,
+//column c being read order[c]-th. The last row is left short, there is no padding.
+func ColumnarEncrypt(text []byte, order []int) ([]byte, error) {
+	if err := checkOrder(order); err != nil {
+		return nil, err
+	}
+
+	w := len(order)
+	out := make([]byte, 0, len(text))
+	for _, c := range readOrder(order) {
+		for i := c; i < len(text); i += w {
+			out = append(out, text[i])
+		}
+	}
+	return out, nil
+}
+
+//ColumnarDecrypt reverses ColumnarEncrypt
+func ColumnarDecrypt(text []byte, order []int) ([]byte, error) {
+	if err := checkOrder(order); err != nil {
+		return nil, err
+	}
+
+	w := len(order)
+	out := make([]byte, len(text))
+	pos := 0
+	for _, c := range readOrder(order) {
+		for i := c; i < len(text); i += w {
+			out[i] = text[pos]
+			pos++
+		}
+	}
+	return out, nil
+}
+
+//railPattern returns the rail of each position of an n byte text on the given number
+//of rails, zigzagging from the top one down and back up
+func railPattern(n, rails int) []int {
+	pattern := make([]int, n)
+	rail, dir := 0, 1
+	for i := range pattern {
+		pattern[i] = rail
+		if rails > 1 {
+			if rail+dir < 0 || rail+dir >= rails {
+				dir = -dir
+			}
+			rail += dir
+		}
+	}
+	return pattern
+}
+
+//railOrder returns the positions of an n byte text in the order the rails read them
+func railOrder(n, rails int) []int {
+	pattern := railPattern(n, rails)
+	idx := make([]int, n)
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(i, j int) bool { return pattern[idx[i]] < pattern[idx[j]] })
+	return idx
+}
+
+//RailFenceEncrypt writes text in a zigzag over the rails and reads it out rail by rail
+func RailFenceEncrypt(text []byte, rails int) ([]byte, error) {
+	if rails < 1 {
+		return nil, ErrWidth
+	}
+
+	out := make([]byte, len(text))
+	for i, p := range railOrder(len(text), rails) {
+		out[i] = text[p]
+	}
+	return out, nil
+}
+
+//RailFenceDecrypt reverses RailFenceEncrypt
+func RailFenceDecrypt(text []byte, rails int) ([]byte, error) {
+	if rails < 1 {
+		return nil, ErrWidth
+	}
+
+	out := make([]byte, len(text))
+	for i, p := range railOrder(len(text), rails) {
+		out[p] = text[i]
+	}
+	return out, nil
+}
+
+//TranspositionOptions configures the transposition breakers. Zero fields get the
+//defaults documented on each.
+type TranspositionOptions struct {
+	//MaxWidth is the most columns or rails tried, 12 by default
+	MaxWidth int
+	//Exhaustive is the widest columnar key whose orders are all tried, 6 by
+	//default. Wider keys are found by hill climbing.
+	Exhaustive int
+	//Restarts and Iterations bound the hill climbing of each width, 5 climbs of at
+	//most 3000 swaps by default
+	Restarts, Iterations int
+	//Text scores the candidate plaintexts, the English quadgram model by default
+	Text score.Scorer
+	//Seed seeds the hill climbing so that runs can be repeated
+	Seed int64
+}
+
+func (o *TranspositionOptions) defaults() {
+	if o.MaxWidth == 0 {
+		o.MaxWidth = 12
+	}
+	if o.Exhaustive == 0 {
+		o.Exhaustive = 6
+	}
+	if o.Restarts == 0 {
+		o.Restarts = 5
+	}
+	if o.Iterations == 0 {
+		o.Iterations = 3000
+	}
+	if o.Text == nil {
+		o.Text = score.EnglishModel()
+	}
+}
+
+//TranspositionResult is the outcome of the transposition breakers
+type TranspositionResult struct {
+	//Order is the columnar key found, nil for rail fence
+	Order []int
+	//Rails is the rail count found, zero for columnar
+	Rails     int
+	Plaintext []byte
+	//Score is what TranspositionOptions.Text gave Plaintext
+	Score float64
+}
+
+//widthGain is how much better, in log10 per byte, a wider key has to score to be
+//preferred. More columns can always be arranged to fit a little better.
+const widthGain = 0.05
+
+//BreakRailFence tries every rail count up to opts.MaxWidth. It returns ErrWidth when
+//not even two rails fit.
+func BreakRailFence(ctxt []byte, opts TranspositionOptions) (*TranspositionResult, error) {
+	opts.defaults()
+	//two rails need a third byte to be anything but the plaintext
+	if len(ctxt) < 3 || opts.MaxWidth < 2 {
+		return nil, ErrWidth
+	}
+
+	var best *TranspositionResult
+	for rails := 2; rails <= opts.MaxWidth && rails < len(ctxt); rails++ {
+		pt, _ := RailFenceDecrypt(ctxt, rails)
+		res := &TranspositionResult{Rails: rails, Plaintext: pt, Score: opts.Text.Score(pt)}
+		if best == nil || res.Score > best.Score {
+			best = res
+		}
+	}
+	return best, nil
+}
+
+//BreakColumnar tries every key width up to opts.MaxWidth, searching the column orders
+//of each exhaustively when narrow and by hill climbing when not. It returns ErrWidth
+//when not even two columns fit.
+func BreakColumnar(ctxt []byte, opts TranspositionOptions) (*TranspositionResult, error) {
+	opts.defaults()
+	if len(ctxt) < 4 || opts.MaxWidth < 2 {
+		return nil, ErrWidth
+	}
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	var best *TranspositionResult
+
+	for w := 2; w <= opts.MaxWidth && w <= len(ctxt)/2; w++ {
+		var res *TranspositionResult
+		if w <= opts.Exhaustive {
+			res = columnarExhaustive(ctxt, w, opts.Text)
+		} else {
+			res = columnarClimb(ctxt, w, rng, opts)
+		}
+
+		if best == nil || res.Score > best.Score+widthGain {
+			best = res
+		}
+	}
+	return best, nil
+}
+
+//BreakTransposition breaks ctxt as rail fence and as columnar and keeps the better
+func BreakTransposition(ctxt []byte, opts TranspositionOptions) (*TranspositionResult, error) {
+	rail, err := BreakRailFence(ctxt, opts)
+	if err != nil {
+		return nil, err
+	}
+	col, err := BreakColumnar(ctxt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if col.Score > rail.Score {
+		return col, nil
+	}
+	return rail, nil
+}
+
+//columnarResult decrypts ctxt with order and scores it
+func columnarResult(ctxt []byte, order []int, s score.Scorer) *TranspositionResult {
+	pt, _ := ColumnarDecrypt(ctxt, order)
+	return &TranspositionResult{Order: append([]int(nil), order...), Plaintext: pt, Score: s.Score(pt)}
+}
+
+//columnarExhaustive tries every order of w columns
+func columnarExhaustive(ctxt []byte, w int, s score.Scorer) *TranspositionResult {
+	order := make([]int, w)
+	for i := range order {
+		order[i] = i
+	}
+
+	var best *TranspositionResult
+	permute(order, 0, func(o []int) {
+		if res := columnarResult(ctxt, o, s); best == nil || res.Score > best.Score {
+			best = res
+		}
+	})
+	return best
+}
+
+//permute calls f with every permutation of order[k:], in place
+func permute(order []int, k int, f func([]int)) {
+	if k == len(order) {
+		f(order)
+		return
+	}
+	for i := k; i < len(order); i++ {
+		order[k], order[i] = order[i], order[k]
+		permute(order, k+1, f)
+		order[k], order[i] = order[i], order[k]
+	}
+}
+
+//columnarClimb hill climbs on the order of w columns from random starts, swapping
+//the read order of two columns at a time
+func columnarClimb(ctxt []byte, w int, rng *rand.Rand, opts TranspositionOptions) *TranspositionResult {
+	var best *TranspositionResult
+
+	for r := 0; r < opts.Restarts; r++ {
+		cur := columnarResult(ctxt, rng.Perm(w), opts.Text)
+
+		//swapping gets stuck on an order that puts every column one to the side of
+		//where it belongs, the text then only goes wrong at the ends of the rows
+		for {
+			cur = climbSwaps(ctxt, cur, rng, opts)
+			rotated := bestRotation(ctxt, cur, opts.Text)
+			if rotated.Score <= cur.Score {
+				break
+			}
+			cur = rotated
+		}
+
+		if best == nil || cur.Score > best.Score {
+			best = cur
+		}
+	}
+
+	return best
+}
+
+//climbSwaps swaps the read order of two columns at a time, keeping the swaps that
+//improve the score
+func climbSwaps(ctxt []byte, cur *TranspositionResult, rng *rand.Rand, opts TranspositionOptions) *TranspositionResult {
+	w := len(cur.Order)
+	order := append([]int(nil), cur.Order...)
+	stale := 0
+
+	for it := 0; it < opts.Iterations && stale < opts.Iterations/10; it++ {
+		a, b := rng.Intn(w), rng.Intn(w)
+		if a == b {
+			continue
+		}
+
+		order[a], order[b] = order[b], order[a]
+		if next := columnarResult(ctxt, order, opts.Text); next.Score > cur.Score {
+			cur, stale = next, 0
+			continue
+		}
+		order[a], order[b] = order[b], order[a]
+		stale++
+	}
+
+	return cur
+}
+
+//bestRotation returns the best scoring of the orders that move every column of cur
+//the same number of places to the side
+func bestRotation(ctxt []byte, cur *TranspositionResult, s score.Scorer) *TranspositionResult {
+	w := len(cur.Order)
+	best := cur
+	order := make([]int, w)
+
+	for shift := 1; shift < w; shift++ {
+		for c := range order {
+			order[c] = cur.Order[(c+shift)%w]
+		}
+		if res := columnarResult(ctxt, order, s); res.Score > best.Score {
+			best = res
+		}
+	}
+
+	return best
+}
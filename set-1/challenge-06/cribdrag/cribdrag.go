@@ -0,0 +1,254 @@
+/*
+Crib dragging
+Interactive attack on ciphertexts that reuse a keystream. Each line of the input
+files is one ciphertext. Guess a word one of the messages might contain, drag it
+across that message and read what it implies for the others; lock the guesses that
+read right and the keystream estimate they fix is shared by every message.
+
+Usage:
+
+	cribdrag [-enc auto|hex|base64|base64url|raw] [-top 10] [-model model.txt] files...
+
+The ciphertexts are read from the files and the commands from stdin.
+Commands:
+
+	drag <msg> <text>          try text at every offset of message msg
+	lock <msg> <offset> <text> fix the keystream so message msg reads text at offset
+	unlock <offset> <n>        forget n keystream bytes from offset
+	show                       print every message, _ for unknown bytes
+	key                        print the keystream estimate in hex
+	pair <i> <j>               print the XOR of messages i and j
+	help, quit
+
+Text may be written as a Go quoted string to include escapes or trailing spaces.
+*/
+
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-06/mtp"
+)
+
+func main() {
+	encoding := flag.String("enc", "auto", "encoding of the ciphertexts: auto, hex, base64, base64url or raw")
+	top := flag.Int("top", 10, "number of offsets drag prints")
+	modelFile := flag.String("model", "", "score with a model written by the train command")
+	flag.Parse()
+
+	//stdin is where the commands come from, so the ciphertexts must not
+	if flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "Error: expected the files of ciphertexts")
+		flag.Usage()
+		os.Exit(2)
+	}
+	for _, name := range flag.Args() {
+		if name == "-" {
+			fmt.Fprintln(os.Stderr, "Error: the ciphertexts cannot be read from stdin")
+			os.Exit(2)
+		}
+	}
+
+	enc, err := input.ParseEncoding(*encoding)
+	checkErr(err)
+
+	var s score.Scorer = score.EnglishModel()
+	if *modelFile != "" {
+		m, err := score.LoadFile(*modelFile)
+		checkErr(err)
+		s = m
+	}
+
+	records, err := input.Load(flag.Args(), input.Options{Encoding: enc, Lines: true})
+	checkErr(err)
+	var ctxts [][]byte
+	for _, r := range records {
+		ctxts = append(ctxts, r.Data)
+	}
+
+	sess, err := mtp.New(ctxts)
+	checkErr(err)
+
+	fmt.Printf("%d messages, type help for the commands\n", sess.Len())
+	repl(sess, s, *top, os.Stdin, os.Stdout)
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
+	}
+}
+
+//repl reads commands from in until it ends or quit, errors are reported and skipped
+func repl(sess *mtp.Session, s score.Scorer, top int, in io.Reader, out io.Writer) {
+	sc := bufio.NewScanner(in)
+
+	for fmt.Fprint(out, "> "); sc.Scan(); fmt.Fprint(out, "> ") {
+		cmd, args := split(sc.Text())
+		if cmd == "quit" {
+			return
+		}
+		if err := run(sess, s, top, cmd, args, out); err != nil {
+			fmt.Fprintln(out, "Error: ", err)
+		}
+	}
+	fmt.Fprintln(out)
+}
+
+//split returns the first word of line and the rest with one separating space removed
+func split(line string) (string, string) {
+	line = strings.TrimLeft(line, " \t")
+	i := strings.IndexAny(line, " \t")
+	if i < 0 {
+		return line, ""
+	}
+	return line[:i], line[i+1:]
+}
+
+func run(sess *mtp.Session, s score.Scorer, top int, cmd, args string, out io.Writer) error {
+	switch cmd {
+	case "":
+		return nil
+
+	case "help":
+		fmt.Fprintln(out, "drag <msg> <text> | lock <msg> <offset> <text> | unlock <offset> <n> | show | key | pair <i> <j> | quit")
+		return nil
+
+	case "drag":
+		msg, rest, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		crib, err := text(rest)
+		if err != nil {
+			return err
+		}
+		drags, err := sess.Drag(msg, crib, s, top)
+		if err != nil {
+			return err
+		}
+		for _, d := range drags {
+			fmt.Fprintf(out, "offset %d score %.3f", d.Offset, d.Score)
+			if d.Conflicts > 0 {
+				fmt.Fprintf(out, " (%d conflicts)", d.Conflicts)
+			}
+			fmt.Fprintln(out)
+			for _, f := range d.Fragments {
+				fmt.Fprintf(out, "\t%3d: %q\n", f.Message, f.Text)
+			}
+		}
+		return nil
+
+	case "lock":
+		msg, rest, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		off, rest, err := intArg(rest)
+		if err != nil {
+			return err
+		}
+		guess, err := text(rest)
+		if err != nil {
+			return err
+		}
+		return sess.Lock(msg, off, guess)
+
+	case "unlock":
+		off, rest, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		n, _, err := intArg(rest)
+		if err != nil {
+			return err
+		}
+		sess.Unlock(off, n)
+		return nil
+
+	case "show":
+		for i := 0; i < sess.Len(); i++ {
+			pt, known := sess.Plaintext(i)
+			fmt.Fprintf(out, "%3d: %s\n", i, render(pt, known))
+		}
+		return nil
+
+	case "key":
+		key, known := sess.Keystream()
+		for i, b := range key {
+			if known[i] {
+				fmt.Fprintf(out, "%02x", b)
+			} else {
+				fmt.Fprint(out, "__")
+			}
+		}
+		fmt.Fprintln(out)
+		return nil
+
+	case "pair":
+		i, rest, err := intArg(args)
+		if err != nil {
+			return err
+		}
+		j, _, err := intArg(rest)
+		if err != nil {
+			return err
+		}
+		if i < 0 || j < 0 || i >= sess.Len() || j >= sess.Len() {
+			return errors.New("no such message")
+		}
+		fmt.Fprintf(out, "%x\n", sess.Pair(i, j))
+		return nil
+	}
+
+	return errors.New("unknown command " + cmd + ", try help")
+}
+
+//intArg parses the first word of args as an int and returns the rest
+func intArg(args string) (int, string, error) {
+	word, rest := split(args)
+	n, err := strconv.Atoi(word)
+	if err != nil {
+		return 0, "", fmt.Errorf("expected a number, got %q", word)
+	}
+	return n, rest, nil
+}
+
+//text returns args as is, or unquoted when it is a Go string literal
+func text(args string) ([]byte, error) {
+	if strings.HasPrefix(args, `"`) {
+		s, err := strconv.Unquote(strings.TrimSpace(args))
+		return []byte(s), err
+	}
+	if args == "" {
+		return nil, errors.New("missing text")
+	}
+	return []byte(args), nil
+}
+
+//render shows the known bytes of pt, printable ones as they are and others as dots
+func render(pt []byte, known []bool) string {
+	var b strings.Builder
+	for i, c := range pt {
+		switch {
+		case !known[i]:
+			b.WriteByte('_')
+		case c < 0x20 || c >= 0x7f:
+			b.WriteByte('.')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+package mtp
+
+import (
+	"cryptopals/set-1/challenge-02/xor"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-03/singlexor"
+	"cryptopals/set-1/challenge-06/repxor"
+)
+
+//Result is the outcome of Break
+type Result struct {
+	//Keystream is as long as the longest ciphertext
+	Keystream  []byte
+	Plaintexts [][]byte
+	//Columns describes how each byte of Keystream was chosen
+	Columns []repxor.Column
+	//Common is the length of the shortest ciphertext. Keystream bytes past it were
+	//recovered from fewer messages and deserve less trust.
+	Common int
+}
+
+//Break recovers the keystream shared by ctxts statistically. Truncated to their
+//common length the ciphertexts laid end to end are a repeating-key XOR whose key is
+//the keystream, so that part is solved column by column with repxor.FindKey. Each
+//byte past it is solved the same way from the ciphertexts long enough to reach it.
+//s scores a column of plaintext bytes, the English byte model when nil.
+func Break(ctxts [][]byte, s score.Scorer) (*Result, error) {
+	if len(ctxts) < 2 {
+		return nil, ErrTooFew
+	}
+	if s == nil {
+		s = score.EnglishModel().WithOrder(1)
+	}
+
+	common, longest := len(ctxts[0]), 0
+	for _, c := range ctxts {
+		if len(c) < common {
+			common = len(c)
+		}
+		if len(c) > longest {
+			longest = len(c)
+		}
+	}
+
+	res := &Result{Common: common}
+
+	if common > 0 {
+		joined := make([]byte, 0, common*len(ctxts))
+		for _, c := range ctxts {
+			joined = append(joined, c[:common]...)
+		}
+		res.Keystream, res.Columns = repxor.FindKey(joined, common, s)
+	}
+
+	col := make([]byte, 0, len(ctxts))
+	for i := common; i < longest; i++ {
+		col = col[:0]
+		for _, c := range ctxts {
+			if i < len(c) {
+				col = append(col, c[i])
+			}
+		}
+
+		top := singlexor.Rank(col, s, 1)[0]
+		res.Keystream = append(res.Keystream, top.Key)
+		res.Columns = append(res.Columns, repxor.Column{Key: top.Key, Score: top.Score, Margin: top.Margin})
+	}
+
+	for _, c := range ctxts {
+		pt, _ := xor.Combine(c, res.Keystream, xor.Truncate)
+		res.Plaintexts = append(res.Plaintexts, pt)
+	}
+
+	return res, nil
+}
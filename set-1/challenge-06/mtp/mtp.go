@@ -0,0 +1,184 @@
+//Package mtp helps break a many-time pad: several messages encrypted with XOR under
+//the same keystream. Knowing any stretch of one plaintext gives the keystream there
+//and so the same stretch of every other plaintext. Dragging a guessed crib across one
+//message and reading what falls out of the others is how such guesses are found.
+//With enough messages Break recovers the keystream statistically instead.
+package mtp
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"cryptopals/set-1/challenge-02/xor"
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//ErrTooFew is returned by New when there is nothing to compare a ciphertext against
+var ErrTooFew = errors.New("mtp: need at least two ciphertexts")
+
+//ConflictError is returned by Lock when a guess disagrees with keystream bytes that
+//are already locked
+type ConflictError struct {
+	//Offset is the first keystream byte in disagreement
+	Offset int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("mtp: guess disagrees with the locked keystream at offset %d", e.Offset)
+}
+
+//Session holds the ciphertexts and the keystream recovered so far
+type Session struct {
+	ctxts [][]byte
+	key   []byte
+	known []bool
+}
+
+//New starts a session on ciphertexts that share a keystream from their first byte
+func New(ctxts [][]byte) (*Session, error) {
+	if len(ctxts) < 2 {
+		return nil, ErrTooFew
+	}
+
+	longest := 0
+	s := &Session{ctxts: make([][]byte, len(ctxts))}
+	for i, c := range ctxts {
+		s.ctxts[i] = append([]byte(nil), c...)
+		if len(c) > longest {
+			longest = len(c)
+		}
+	}
+	s.key = make([]byte, longest)
+	s.known = make([]bool, longest)
+
+	return s, nil
+}
+
+//Len returns the number of ciphertexts
+func (s *Session) Len() int {
+	return len(s.ctxts)
+}
+
+//Ciphertext returns ciphertext i
+func (s *Session) Ciphertext(i int) []byte {
+	return s.ctxts[i]
+}
+
+//Pair returns ciphertexts i and j XORed over their common length, which is the XOR
+//of the two plaintexts: the keystream cancels out.
+func (s *Session) Pair(i, j int) []byte {
+	out, _ := xor.Combine(s.ctxts[i], s.ctxts[j], xor.Truncate)
+	return out
+}
+
+//Fragment is the plaintext a guess implies for one of the other messages
+type Fragment struct {
+	Message int
+	Text    []byte
+}
+
+//Drag is one position of a crib dragged across a message
+type Drag struct {
+	Offset int
+	//Score is the average score of the fragments
+	Score     float64
+	Fragments []Fragment
+	//Conflicts counts the crib bytes that disagree with the locked keystream
+	Conflicts int
+}
+
+//Drag places crib at every offset of message msg and returns what each placement
+//implies for the other messages, best scoring first. At most n placements are
+//returned, all of them when n is not positive.
+func (s *Session) Drag(msg int, crib []byte, sc score.Scorer, n int) ([]Drag, error) {
+	if msg < 0 || msg >= len(s.ctxts) {
+		return nil, fmt.Errorf("mtp: no message %d", msg)
+	}
+	if len(crib) == 0 {
+		return nil, errors.New("mtp: empty crib")
+	}
+
+	ctxt := s.ctxts[msg]
+	var drags []Drag
+
+	for off := 0; off+len(crib) <= len(ctxt); off++ {
+		stream, _ := xor.Fixed(ctxt[off:off+len(crib)], crib)
+		d := Drag{Offset: off}
+
+		for i, b := range stream {
+			if s.known[off+i] && s.key[off+i] != b {
+				d.Conflicts++
+			}
+		}
+
+		for k, other := range s.ctxts {
+			if k == msg || len(other) <= off {
+				continue
+			}
+			text, _ := xor.Combine(other[off:], stream, xor.Truncate)
+			d.Fragments = append(d.Fragments, Fragment{Message: k, Text: text})
+			d.Score += sc.Score(text)
+		}
+
+		if len(d.Fragments) > 0 {
+			d.Score /= float64(len(d.Fragments))
+		}
+		drags = append(drags, d)
+	}
+
+	sort.SliceStable(drags, func(i, j int) bool { return drags[i].Score > drags[j].Score })
+	if n > 0 && len(drags) > n {
+		drags = drags[:n]
+	}
+
+	return drags, nil
+}
+
+//Lock records that message msg reads text at offset, fixing the keystream there.
+//It fails without changing anything if the guess disagrees with locked bytes.
+func (s *Session) Lock(msg, offset int, text []byte) error {
+	if msg < 0 || msg >= len(s.ctxts) {
+		return fmt.Errorf("mtp: no message %d", msg)
+	}
+	ctxt := s.ctxts[msg]
+	if offset < 0 || offset+len(text) > len(ctxt) {
+		return fmt.Errorf("mtp: guess does not fit in message %d", msg)
+	}
+
+	stream, _ := xor.Fixed(ctxt[offset:offset+len(text)], text)
+	for i, b := range stream {
+		if s.known[offset+i] && s.key[offset+i] != b {
+			return &ConflictError{Offset: offset + i}
+		}
+	}
+
+	copy(s.key[offset:], stream)
+	for i := range stream {
+		s.known[offset+i] = true
+	}
+
+	return nil
+}
+
+//Unlock forgets n keystream bytes from offset
+func (s *Session) Unlock(offset, n int) {
+	for i := offset; i < offset+n && i < len(s.key); i++ {
+		if i >= 0 {
+			s.key[i], s.known[i] = 0, false
+		}
+	}
+}
+
+//Keystream returns the keystream estimate and which of its bytes are locked
+func (s *Session) Keystream() ([]byte, []bool) {
+	return append([]byte(nil), s.key...), append([]bool(nil), s.known...)
+}
+
+//Plaintext returns message i decrypted with the keystream estimate and which of its
+//bytes are known. The unknown ones are left as the ciphertext XOR zero.
+func (s *Session) Plaintext(i int) ([]byte, []bool) {
+	ctxt := s.ctxts[i]
+	pt, _ := xor.Combine(ctxt, s.key, xor.Truncate)
+	return pt, append([]bool(nil), s.known[:len(ctxt)]...)
+}
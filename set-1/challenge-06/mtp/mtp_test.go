@@ -0,0 +1,155 @@
+package mtp
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+
+	"cryptopals/set-1/challenge-02/xor"
+	"cryptopals/set-1/challenge-03/score"
+)
+
+var messages = []string{
+	"the quick brown fox jumps over the lazy dog",
+	"never send a human to do a machine's job",
+	"we attack at dawn, bring the ladders",
+	"keystream reuse is a classic mistake",
+}
+
+func session(t *testing.T) *Session {
+	stream := make([]byte, 64)
+	rand.New(rand.NewSource(1)).Read(stream)
+
+	var ctxts [][]byte
+	for _, m := range messages {
+		ct, err := xor.Combine([]byte(m), stream, xor.Truncate)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctxts = append(ctxts, ct)
+	}
+
+	s, err := New(ctxts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDrag(t *testing.T) {
+	s := session(t)
+
+	drags, err := s.Drag(2, []byte(" attack "), score.EnglishModel(), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(drags) != 3 || drags[0].Offset != 2 {
+		t.Fatalf("Expected offset 2 first, got %+v", drags)
+	}
+	for _, f := range drags[0].Fragments {
+		if want := messages[f.Message][2:10]; string(f.Text) != want {
+			t.Errorf("Expected %q in message %d, got %q", want, f.Message, f.Text)
+		}
+	}
+
+	if pair := s.Pair(0, 1); len(pair) != len(messages[1]) || pair[0] != 't'^'n' {
+		t.Errorf("Pair should be the XOR of the plaintexts")
+	}
+}
+
+func TestLock(t *testing.T) {
+	s := session(t)
+
+	if err := s.Lock(0, 4, []byte("quick")); err != nil {
+		t.Fatal(err)
+	}
+	pt, known := s.Plaintext(1)
+	if string(pt[4:9]) != messages[1][4:9] || !known[4] || known[3] || known[9] {
+		t.Errorf("Expected %q known, got %q", messages[1][4:9], pt[4:9])
+	}
+
+	//agreeing guesses may overlap, disagreeing ones may not
+	if err := s.Lock(3, 5, []byte("ream")); err != nil {
+		t.Errorf("Unexpected error %v", err)
+	}
+	err := s.Lock(1, 4, []byte("XXXXX"))
+	if ce, ok := err.(*ConflictError); !ok || ce.Offset != 4 {
+		t.Errorf("Expected a conflict at 4, got %v", err)
+	}
+
+	drags, _ := s.Drag(1, []byte("XX"), score.Printable{}, 0)
+	for _, d := range drags {
+		if d.Offset == 4 && d.Conflicts != 2 {
+			t.Errorf("Expected 2 conflicts at 4, got %d", d.Conflicts)
+		}
+	}
+
+	s.Unlock(0, 8)
+	if _, known := s.Keystream(); known[7] || !known[8] {
+		t.Errorf("Expected byte 8 to stay locked")
+	}
+
+	if _, err := New([][]byte{{1}}); err != ErrTooFew {
+		t.Errorf("Expected %v, got %v", ErrTooFew, err)
+	}
+}
+
+//lyrics returns the lines of the challenge 6 plaintext
+func lyrics(t *testing.T) [][]byte {
+	file, err := ioutil.ReadFile("../file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := base64.StdEncoding.DecodeString(string(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pt, _ := xor.Repeating(ct, []byte("Terminator X: Bring the noise"))
+
+	var lines [][]byte
+	for _, l := range bytes.Split(pt, []byte("\n")) {
+		if len(l) > 0 {
+			lines = append(lines, l)
+		}
+	}
+	return lines
+}
+
+func TestBreak(t *testing.T) {
+	lines := lyrics(t)
+	stream := make([]byte, 128)
+	rand.New(rand.NewSource(2)).Read(stream)
+
+	var ctxts [][]byte
+	for _, l := range lines {
+		ct, _ := xor.Combine(l, stream, xor.Truncate)
+		ctxts = append(ctxts, ct)
+	}
+
+	res, err := Break(ctxts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Plaintexts) != len(lines) || len(res.Keystream) != len(res.Columns) {
+		t.Fatalf("Expected %d plaintexts and a column per keystream byte", len(lines))
+	}
+
+	//past the common part the columns thin out, only the first few bytes past it are
+	//still solved from many messages
+	right, total := 0, 0
+	for i, pt := range res.Plaintexts {
+		for j := range pt {
+			if j < 24 {
+				total++
+				if pt[j] == lines[i][j] {
+					right++
+				}
+			}
+		}
+	}
+	if right < total*90/100 {
+		t.Errorf("Expected 90%% of the first 24 bytes right, got %d of %d", right, total)
+	}
+}
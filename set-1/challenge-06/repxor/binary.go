@@ -0,0 +1,131 @@
+package repxor
+
+import (
+	"sort"
+
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//Mode tells Break what kind of plaintext to expect
+type Mode int
+
+const (
+	//Text expects prose and scores it with language statistics
+	Text Mode = iota
+	//Binary expects compressed or structured data. Key bytes come from runs of zero
+	//bytes and from the magic bytes of formats whose validator then accepts the
+	//plaintext; the remaining columns are solved by assuming zero is their most
+	//common byte.
+	Binary
+	//Auto tries both and keeps Binary when a format validator accepts its plaintext,
+	//Text when its plaintext is printable and Binary otherwise
+	Auto
+)
+
+//maxZeroRuns bounds the number of zero runs turned into cribs for a key size
+const maxZeroRuns = 64
+
+//BinaryText scores a whole binary plaintext: lower entropy is better and passing a
+//format validator is worth more than any entropy difference.
+var BinaryText score.Scorer = score.ScorerFunc(func(pt []byte) float64 {
+	s := -score.Entropy(pt)
+	if Identify(pt) != "" {
+		s += 8
+	}
+	return s
+})
+
+//ZeroRuns returns cribs for the runs of zero bytes the plaintext appears to hold,
+//longest first. Under a size long key a run of zeros at least size long shows up as
+//ciphertext repeating with period size, and since XOR with zero gives back the key
+//those ciphertext bytes are the key bytes themselves.
+func ZeroRuns(ctxt []byte, size int) []Crib {
+	var cribs []Crib
+	start := -1
+
+	for i := 0; i+size <= len(ctxt); i++ {
+		if i+size < len(ctxt) && ctxt[i] == ctxt[i+size] {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+
+		//ctxt[start:i+size] repeats with period size, so does its plaintext
+		if start >= 0 && i-start >= size {
+			cribs = append(cribs, Crib{Offset: start, Text: make([]byte, i-start+size)})
+		}
+		start = -1
+	}
+
+	sort.SliceStable(cribs, func(i, j int) bool { return len(cribs[i].Text) > len(cribs[j].Text) })
+	return cribs
+}
+
+//withZeroRuns adds to base the zero runs of ctxt that agree with it and with each
+//other. A run of some other repeated byte would imply a different key, so the longer
+//runs win.
+func withZeroRuns(ctxt []byte, size int, base []Crib) []Crib {
+	cribs := append([]Crib(nil), base...)
+
+	for i, run := range ZeroRuns(ctxt, size) {
+		if i == maxZeroRuns {
+			break
+		}
+		if _, _, err := CribKey(ctxt, size, append(cribs, run)); err == nil {
+			cribs = append(cribs, run)
+		}
+	}
+
+	return cribs
+}
+
+//solveBinary recovers a key of the given size for binary plaintext. Every format with
+//a validator is tried as a crib on top of the zero runs, a variant whose plaintext
+//validates wins over the purely statistical one.
+func solveBinary(ctxt []byte, size int, opts Options) (Result, error) {
+	best, err := solveWith(ctxt, size, withZeroRuns(ctxt, size, opts.Cribs), opts)
+	if err != nil {
+		return Result{}, err
+	}
+
+	for _, name := range Formats {
+		cribs := append(append([]Crib(nil), opts.Cribs...), Magic[name])
+		res, err := solveWith(ctxt, size, withZeroRuns(ctxt, size, cribs), opts)
+		if err != nil || !Validators[name](res.Plaintext) {
+			continue
+		}
+
+		if res.Score > best.Score {
+			best = res
+		}
+	}
+
+	return best, nil
+}
+
+//breakAuto runs Break in both modes and picks one as described on Auto
+func breakAuto(ctxt []byte, opts Options) (*Report, error) {
+	opts.Mode = Text
+	text, textErr := Break(ctxt, opts)
+	opts.Mode = Binary
+	bin, binErr := Break(ctxt, opts)
+
+	switch {
+	case textErr != nil && binErr != nil:
+		return nil, textErr
+	case textErr != nil:
+		return bin, nil
+	case binErr != nil:
+		return text, nil
+	}
+
+	pick, other := bin, text
+	if Identify(bin.Plaintext) == "" && (score.Printable{}).Score(text.Plaintext) >= 0.95 {
+		pick, other = text, bin
+	}
+
+	pick.Alternatives = append(pick.Alternatives, other.Result)
+	pick.Alternatives = append(pick.Alternatives, other.Alternatives...)
+	return pick, nil
+}
@@ -0,0 +1,259 @@
+package repxor
+
+import (
+	"errors"
+	"sort"
+
+	"cryptopals/set-1/challenge-02/xor"
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//Options configures Break. Zero fields get the defaults documented on each.
+type Options struct {
+	//MinSize and MaxSize bound the key sizes tried, 2 and 40 by default
+	MinSize, MaxSize int
+	//Candidates is the number of key sizes to recover a key for, 3 by default
+	Candidates int
+	//Mode is the kind of plaintext to expect, Text by default
+	Mode Mode
+	//Estimator ranks the key sizes, ByHamming by default or ByCoincidence in Binary
+	//mode
+	Estimator Estimator
+	//Column scores the candidates for a single key byte. Columns are not contiguous
+	//text so the default is the English byte model, or score.Zeros in Binary mode.
+	Column score.Scorer
+	//Text scores the full plaintext of each hypothesis, the English quadgram model
+	//by default or BinaryText in Binary mode
+	Text score.Scorer
+	//Tolerance is the fraction of key bytes allowed to disagree when checking
+	//whether a key is a repeat of a shorter one, 0.25 by default
+	Tolerance float64
+	//ExactPeriod only cuts a key down when it is a shorter key repeated exactly, as
+	//a Tolerance of zero would if zero did not mean the default
+	ExactPeriod bool
+	//Cribs is known plaintext. The key bytes it implies are used as they are, and
+	//key sizes the cribs are inconsistent with are skipped.
+	Cribs []Crib
+}
+
+func (o *Options) defaults() {
+	if o.MinSize == 0 {
+		o.MinSize = 2
+	}
+	if o.MaxSize == 0 {
+		o.MaxSize = 40
+	}
+	if o.Candidates == 0 {
+		o.Candidates = 3
+	}
+	if o.Mode == Binary {
+		if o.Estimator == nil {
+			o.Estimator = ByCoincidence
+		}
+		if o.Column == nil {
+			o.Column = score.Zeros{}
+		}
+		if o.Text == nil {
+			o.Text = BinaryText
+		}
+	}
+	if o.Estimator == nil {
+		o.Estimator = ByHamming
+	}
+	if o.Column == nil {
+		o.Column = score.EnglishModel().WithOrder(1)
+	}
+	if o.Text == nil {
+		o.Text = score.EnglishModel()
+	}
+	if o.Tolerance == 0 && !o.ExactPeriod {
+		o.Tolerance = 0.25
+	}
+}
+
+//check rejects the options that have no meaning rather than letting them through to
+//the defaults
+func (o *Options) check() error {
+	switch {
+	case o.Candidates < 0:
+		return errors.New("repxor: negative number of candidates")
+	case o.Tolerance < 0 || o.Tolerance >= 1:
+		return errors.New("repxor: tolerance must be from 0 up to 1")
+	case o.ExactPeriod && o.Tolerance != 0:
+		return errors.New("repxor: exact period and a tolerance are both set")
+	}
+	return nil
+}
+
+//Column is the recovered key byte of one column and how sure the scorer was of it
+type Column struct {
+	Key   byte
+	Score float64
+	//Margin is how far the chosen byte scored ahead of the runner up
+	Margin float64
+	//Known is set when the byte came from a crib, or in Binary mode a run of zeros,
+	//rather than from scoring
+	Known bool
+}
+
+//Result is one key size hypothesis taken all the way to a plaintext
+type Result struct {
+	KeySize   int
+	Key       []byte
+	Plaintext []byte
+	//Score is what Options.Text gave Plaintext
+	Score   float64
+	Columns []Column
+	//ReducedFrom is the size the estimator suggested when the key turned out to be
+	//a repeat of a shorter one, zero otherwise
+	ReducedFrom int
+}
+
+//Report is the outcome of Break: the best hypothesis and the others that were tried,
+//best first
+type Report struct {
+	Result
+	Alternatives []Result
+}
+
+//FindKey recovers a key of the given size by transposing ctxt into columns and
+//solving each one as a single-byte XOR with s.
+func FindKey(ctxt []byte, size int, s score.Scorer) ([]byte, []Column) {
+	//without cribs there is nothing to be inconsistent with
+	key, cols, _ := FindKeyWithCribs(ctxt, size, nil, s)
+	return key, cols
+}
+
+//FindKeyOp is FindKey for ciphers other than XOR whose key symbols still each cover
+//a column, like Vigenère. ctxt holds symbols, each of the keys key symbols is tried
+//on every column with decrypt and the one whose plaintext s scores best is kept,
+//the lowest on ties.
+func FindKeyOp(ctxt []byte, size, keys int, decrypt func(c, k byte) byte, s score.Scorer) []byte {
+	key := make([]byte, size)
+
+	for j, col := range Columns(ctxt, size) {
+		pt := make([]byte, len(col))
+		best := 0.0
+
+		for k := 0; k < keys; k++ {
+			for t, c := range col {
+				pt[t] = decrypt(c, byte(k))
+			}
+			if sc := s.Score(pt); k == 0 || sc > best {
+				key[j], best = byte(k), sc
+			}
+		}
+	}
+
+	return key
+}
+
+//Break recovers a repeating XOR key and plaintext from ctxt. It takes the best few key
+//sizes from the estimator, recovers a key for each, and keeps the one whose plaintext
+//scores best.
+func Break(ctxt []byte, opts Options) (*Report, error) {
+	if err := opts.check(); err != nil {
+		return nil, err
+	}
+	if opts.Mode == Auto {
+		return breakAuto(ctxt, opts)
+	}
+	opts.defaults()
+
+	sizes, err := opts.Estimator.KeySizes(ctxt, opts.MinSize, opts.MaxSize)
+	if err != nil {
+		return nil, err
+	}
+	if len(sizes) > opts.Candidates {
+		sizes = sizes[:opts.Candidates]
+	}
+
+	tried := make(map[int]bool)
+	var results []Result
+
+	for _, ks := range sizes {
+		res, err := solve(ctxt, ks.Size, opts)
+		if err != nil {
+			continue
+		}
+
+		//a key that is a repeat of a shorter one means the estimator picked a multiple
+		//of the real size, solve again with the longer columns of the real size
+		if p := Period(res.Key, opts.MinSize, opts.Tolerance); p < ks.Size {
+			if short, err := solve(ctxt, p, opts); err == nil {
+				res = short
+				res.ReducedFrom = ks.Size
+			}
+		}
+
+		if tried[res.KeySize] {
+			continue
+		}
+		tried[res.KeySize] = true
+		results = append(results, res)
+	}
+
+	if len(results) == 0 {
+		return nil, ErrNoConsistentKey
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+
+	return &Report{Result: results[0], Alternatives: results[1:]}, nil
+}
+
+func solve(ctxt []byte, size int, opts Options) (Result, error) {
+	if opts.Mode == Binary {
+		return solveBinary(ctxt, size, opts)
+	}
+	return solveWith(ctxt, size, opts.Cribs, opts)
+}
+
+func solveWith(ctxt []byte, size int, cribs []Crib, opts Options) (Result, error) {
+	key, cols, err := FindKeyWithCribs(ctxt, size, cribs, opts.Column)
+	if err != nil {
+		return Result{}, err
+	}
+	plaintext, _ := xor.Repeating(ctxt, key)
+
+	return Result{
+		KeySize:   size,
+		Key:       key,
+		Plaintext: plaintext,
+		Score:     opts.Text.Score(plaintext),
+		Columns:   cols,
+	}, nil
+}
+
+//Period returns the shortest p of at least min that divides len(key) such that key is,
+//but for a tolerated fraction of bytes, the first p bytes repeated. It returns
+//len(key) when there is none.
+func Period(key []byte, min int, tolerance float64) int {
+	n := len(key)
+
+	for p := min; p < n; p++ {
+		if n%p != 0 {
+			continue
+		}
+
+		//vote for the byte each position of the short key should hold
+		off := 0
+		for i := 0; i < p; i++ {
+			counts := make(map[byte]int)
+			best := 0
+			for j := i; j < n; j += p {
+				counts[key[j]]++
+				if counts[key[j]] > best {
+					best = counts[key[j]]
+				}
+			}
+			off += n/p - best
+		}
+
+		if float64(off) <= tolerance*float64(n) {
+			return p
+		}
+	}
+
+	return n
+}
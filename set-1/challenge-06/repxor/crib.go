@@ -0,0 +1,130 @@
+package repxor
+
+import (
+	"errors"
+	"fmt"
+
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-03/singlexor"
+)
+
+//Crib is plaintext known to appear at Offset. A negative Offset counts back from the
+//end of the ciphertext, for trailers like the end of a ZIP central directory.
+type Crib struct {
+	Offset int
+	Text   []byte
+}
+
+//ErrNoConsistentKey is returned by Break when the cribs rule out every key size tried
+var ErrNoConsistentKey = errors.New("repxor: cribs are inconsistent with every key size")
+
+//ConflictError is returned when two crib bytes imply different bytes for the same
+//position of the key
+type ConflictError struct {
+	//Position is the index into the key
+	Position int
+	//Offsets are the ciphertext offsets that disagree
+	Offsets [2]int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("repxor: cribs at offsets %d and %d disagree on key byte %d", e.Offsets[0], e.Offsets[1], e.Position)
+}
+
+//Magic holds the leading bytes of common file formats, ready to use as cribs
+var Magic = map[string]Crib{
+	//signature, then the length and type of the IHDR chunk that always comes first
+	"png":   {0, []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR")},
+	"zip":   {0, []byte("PK\x03\x04")},
+	"pdf":   {0, []byte("%PDF-1.")},
+	"elf":   {0, []byte("\x7fELF")},
+	"gzip":  {0, []byte("\x1f\x8b\x08")},
+	"jpeg":  {0, []byte("\xff\xd8\xff")},
+	"gif":   {0, []byte("GIF8")},
+	"bmp":   {0, []byte("BM")},
+	"pe":    {0, []byte("MZ")},
+	"class": {0, []byte("\xca\xfe\xba\xbe")},
+	"7z":    {0, []byte("7z\xbc\xaf\x27\x1c")},
+	"bzip2": {0, []byte("BZh")},
+}
+
+//Formats names the formats of Magic that Validators can check, in the order they are
+//tried, so that the answer does not depend on map iteration
+var Formats = []string{"png", "zip", "pdf", "elf", "gzip", "jpeg", "gif"}
+
+//CribKey derives the key bytes of a size long key implied by cribs. known reports
+//which positions of key were derived, the others are left zero.
+func CribKey(ctxt []byte, size int, cribs []Crib) (key []byte, known []bool, err error) {
+	if size < 1 {
+		return nil, nil, errors.New("repxor: invalid key size")
+	}
+
+	key = make([]byte, size)
+	known = make([]bool, size)
+	source := make([]int, size)
+
+	for _, c := range cribs {
+		off := c.Offset
+		if off < 0 {
+			off += len(ctxt)
+		}
+		if off < 0 || off+len(c.Text) > len(ctxt) {
+			return nil, nil, fmt.Errorf("repxor: crib at offset %d does not fit in the ciphertext", c.Offset)
+		}
+
+		for i, b := range c.Text {
+			pos := (off + i) % size
+			k := ctxt[off+i] ^ b
+
+			if known[pos] && key[pos] != k {
+				return nil, nil, &ConflictError{Position: pos, Offsets: [2]int{source[pos], off + i}}
+			}
+			key[pos] = k
+			known[pos] = true
+			source[pos] = off + i
+		}
+	}
+
+	return key, known, nil
+}
+
+//CribOffsets returns every offset at which text could appear under a key of the
+//given size, i.e. where the key bytes it implies agree with each other. Only cribs
+//longer than size can rule anything out.
+func CribOffsets(ctxt []byte, size int, text []byte) []int {
+	var offsets []int
+
+	for off := 0; off+len(text) <= len(ctxt); off++ {
+		if _, _, err := CribKey(ctxt, size, []Crib{{off, text}}); err == nil {
+			offsets = append(offsets, off)
+		}
+	}
+
+	return offsets
+}
+
+//FindKeyWithCribs recovers a key of the given size, taking the key bytes the cribs
+//imply and solving the remaining columns with s.
+func FindKeyWithCribs(ctxt []byte, size int, cribs []Crib, s score.Scorer) ([]byte, []Column, error) {
+	key, known, err := CribKey(ctxt, size, cribs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cols := make([]Column, size)
+	for i, col := range Columns(ctxt, size) {
+		if known[i] {
+			cols[i] = Column{Key: key[i], Known: true}
+			continue
+		}
+		if len(col) == 0 {
+			continue
+		}
+
+		top := singlexor.Rank(col, s, 1)[0]
+		key[i] = top.Key
+		cols[i] = Column{Key: top.Key, Score: top.Score, Margin: top.Margin}
+	}
+
+	return key, cols, nil
+}
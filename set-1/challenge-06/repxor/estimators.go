@@ -0,0 +1,256 @@
+package repxor
+
+import (
+	"errors"
+	"math"
+)
+
+//Estimator ranks the key sizes from min to max that could have produced ctxt, best
+//first
+type Estimator interface {
+	KeySizes(ctxt []byte, min, max int) ([]KeySize, error)
+}
+
+//EstimatorFunc adapts an ordinary function to the Estimator interface
+type EstimatorFunc func(ctxt []byte, min, max int) ([]KeySize, error)
+
+//KeySizes calls f(ctxt, min, max)
+func (f EstimatorFunc) KeySizes(ctxt []byte, min, max int) ([]KeySize, error) {
+	return f(ctxt, min, max)
+}
+
+//EnglishKappa is the index of coincidence of the bytes of English prose, i.e. the
+//chance that two bytes picked at random are equal
+const EnglishKappa = 0.065
+
+//RandomKappa is the index of coincidence of uniformly random bytes
+const RandomKappa = 1.0 / 256
+
+var (
+	//ByHamming ranks key sizes with HammingKeySizes
+	ByHamming Estimator = EstimatorFunc(HammingKeySizes)
+	//ByCoincidence ranks key sizes with CoincidenceKeySizes
+	ByCoincidence Estimator = EstimatorFunc(CoincidenceKeySizes)
+	//DefaultVote combines all of the estimators in this package
+	DefaultVote = Vote{ByHamming, ByCoincidence, Friedman{Kappa: EnglishKappa}, Kasiski{MinLen: 3}}
+)
+
+//Coincidence returns the index of coincidence of buf
+func Coincidence(buf []byte) float64 {
+	if len(buf) < 2 {
+		return 0
+	}
+
+	var counts [256]int
+	for _, b := range buf {
+		counts[b]++
+	}
+
+	total := 0
+	for _, n := range counts {
+		total += n * (n - 1)
+	}
+
+	return float64(total) / float64(len(buf)*(len(buf)-1))
+}
+
+//Columns splits ctxt into the size columns of bytes that share a key byte
+func Columns(ctxt []byte, size int) [][]byte {
+	cols := make([][]byte, size)
+	for i := range cols {
+		cols[i] = make([]byte, 0, len(ctxt)/size+1)
+	}
+	for i, b := range ctxt {
+		cols[i%size] = append(cols[i%size], b)
+	}
+	return cols
+}
+
+//CoincidenceKeySizes ranks key sizes by the average index of coincidence of their
+//columns. XOR with a single byte only relabels bytes, so under the right size every
+//column keeps the uneven byte distribution of the plaintext while wrong sizes mix
+//key bytes and flatten it. Unlike Hamming distance this holds for any plaintext that
+//is not itself random, text or not.
+func CoincidenceKeySizes(ctxt []byte, min, max int) ([]KeySize, error) {
+	max, err := checkRange(ctxt, min, max, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make([]KeySize, 0, max-min+1)
+	for size := min; size <= max; size++ {
+		total := 0.0
+		for _, col := range Columns(ctxt, size) {
+			total += Coincidence(col)
+		}
+		sizes = append(sizes, KeySize{Size: size, Score: total / float64(size)})
+	}
+
+	rank(sizes)
+	return sizes, nil
+}
+
+//Friedman estimates the key size from the index of coincidence of the whole
+//ciphertext, which drops from Kappa towards RandomKappa as the key gets longer.
+//Sizes are ranked by how close they are to the estimate. It is only a rough guide:
+//printable keys only touch the low bits of each byte so the ciphertext never gets
+//as flat as RandomKappa assumes, and long keys are underestimated.
+type Friedman struct {
+	//Kappa is the index of coincidence of the expected plaintext, e.g. EnglishKappa
+	Kappa float64
+}
+
+//Estimate returns the Friedman estimate of the key size of ctxt
+func (f Friedman) Estimate(ctxt []byte) (float64, error) {
+	if len(ctxt) < 2 {
+		return 0, ErrTooShort
+	}
+	if f.Kappa <= RandomKappa {
+		return 0, errors.New("repxor: plaintext kappa must be above that of random bytes")
+	}
+
+	n := float64(len(ctxt))
+	observed := Coincidence(ctxt)
+
+	//the observed index is about (Kappa-Random)/size + Random, which solves to
+	//this once corrected for the size of the sample
+	est := (f.Kappa - RandomKappa) * n / ((n-1)*observed - RandomKappa*n + f.Kappa)
+	if est < 1 || math.IsInf(est, 0) || math.IsNaN(est) {
+		est = 1
+	}
+
+	return est, nil
+}
+
+//KeySizes implements Estimator
+func (f Friedman) KeySizes(ctxt []byte, min, max int) ([]KeySize, error) {
+	max, err := checkRange(ctxt, min, max, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	est, err := f.Estimate(ctxt)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make([]KeySize, 0, max-min+1)
+	for size := min; size <= max; size++ {
+		//relative distance, an estimate of 30 says little about 29 against 31
+		sizes = append(sizes, KeySize{Size: size, Score: 1 / (1 + math.Abs(float64(size)-est)/est)})
+	}
+
+	rank(sizes)
+	return sizes, nil
+}
+
+//Kasiski ranks key sizes by how many of the distances between repeated substrings of
+//the ciphertext they divide. The same plaintext under the same stretch of key gives
+//the same ciphertext, so those distances are multiples of the key size.
+type Kasiski struct {
+	//MinLen is the length of the substrings to look for, 3 when zero
+	MinLen int
+}
+
+//ErrNoRepeats is returned by Kasiski when the ciphertext repeats nothing
+var ErrNoRepeats = errors.New("repxor: no repeated substrings")
+
+//KeySizes implements Estimator
+func (k Kasiski) KeySizes(ctxt []byte, min, max int) ([]KeySize, error) {
+	max, err := checkRange(ctxt, min, max, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	n := k.MinLen
+	if n < 1 {
+		n = 3
+	}
+
+	//distance between each substring and its previous occurence
+	last := make(map[string]int)
+	var spacings []int
+	for i := 0; i+n <= len(ctxt); i++ {
+		sub := string(ctxt[i : i+n])
+		if j, ok := last[sub]; ok {
+			spacings = append(spacings, i-j)
+		}
+		last[sub] = i
+	}
+
+	if len(spacings) == 0 {
+		return nil, ErrNoRepeats
+	}
+
+	sizes := make([]KeySize, 0, max-min+1)
+	for size := min; size <= max; size++ {
+		divides := 0
+		for _, d := range spacings {
+			if d%size == 0 {
+				divides++
+			}
+		}
+
+		//a size divides about 1 in size distances by chance alone, only the excess
+		//says anything
+		frac := float64(divides) / float64(len(spacings))
+		sizes = append(sizes, KeySize{Size: size, Score: frac - 1/float64(size)})
+	}
+
+	rank(sizes)
+	return sizes, nil
+}
+
+//Vote combines several estimators with a Borda count: each one gives its top
+//ballotSize sizes points for how high it ranked them, and the sizes are ranked by
+//their average points. Only the top of each ranking counts so that one estimator
+//going wrong cannot outvote the others agreeing. Estimators that fail, like Kasiski
+//on a ciphertext without repeats, are left out.
+type Vote []Estimator
+
+//ballotSize is the number of sizes each estimator in a Vote gives points to
+const ballotSize = 5
+
+//KeySizes implements Estimator
+func (v Vote) KeySizes(ctxt []byte, min, max int) ([]KeySize, error) {
+	points := make(map[int]float64)
+	voters := 0
+	var firstErr error
+
+	for _, e := range v {
+		sizes, err := e.KeySizes(ctxt, min, max)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+
+		voters++
+		for i, s := range sizes {
+			if i == ballotSize {
+				break
+			}
+			points[s.Size] += float64(ballotSize-i) / ballotSize
+		}
+	}
+
+	if voters == 0 {
+		if firstErr == nil {
+			firstErr = errors.New("repxor: no estimators to vote")
+		}
+		return nil, firstErr
+	}
+
+	//every size that fits is returned, the ones nobody voted for tie at the bottom
+	if max > len(ctxt)/2 {
+		max = len(ctxt) / 2
+	}
+	sizes := make([]KeySize, 0, max-min+1)
+	for size := min; size <= max; size++ {
+		sizes = append(sizes, KeySize{Size: size, Score: points[size] / float64(voters)})
+	}
+
+	rank(sizes)
+	return sizes, nil
+}
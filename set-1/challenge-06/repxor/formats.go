@@ -0,0 +1,124 @@
+package repxor
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+)
+
+//Validators check whether a plaintext is a well formed file of a given format. They
+//look past the magic bytes at fields a wrong key would garble, so a plaintext that
+//passes is strong evidence the key is right.
+var Validators = map[string]func(pt []byte) bool{
+	"png":  validPNG,
+	"zip":  validZIP,
+	"pdf":  validPDF,
+	"elf":  validELF,
+	"gzip": validGzip,
+	"jpeg": validJPEG,
+	"gif":  validGIF,
+}
+
+//Identify returns the name of the first format of Formats whose validator accepts pt,
+//or ""
+func Identify(pt []byte) string {
+	for _, name := range Formats {
+		if Validators[name](pt) {
+			return name
+		}
+	}
+	return ""
+}
+
+//validPNG checks the signature and the CRC of the IHDR chunk
+func validPNG(pt []byte) bool {
+	magic := Magic["png"].Text
+	if len(pt) < 33 || !bytes.HasPrefix(pt, magic) {
+		return false
+	}
+
+	//length(4) type(4) data(13) crc(4), starting right after the 8 byte signature
+	crc := binary.BigEndian.Uint32(pt[29:33])
+	return crc32.ChecksumIEEE(pt[12:29]) == crc
+}
+
+//validZIP checks the first local file header and that the archive has an end of
+//central directory record
+func validZIP(pt []byte) bool {
+	if len(pt) < 30+22 || !bytes.HasPrefix(pt, Magic["zip"].Text) {
+		return false
+	}
+
+	version := binary.LittleEndian.Uint16(pt[4:6])
+	method := binary.LittleEndian.Uint16(pt[8:10])
+	nameLen := int(binary.LittleEndian.Uint16(pt[26:28]))
+	if version > 63 || (method != 0 && method != 8 && method != 12 && method != 14) || nameLen == 0 || 30+nameLen > len(pt) {
+		return false
+	}
+
+	//file names are printable
+	for _, b := range pt[30 : 30+nameLen] {
+		if b < 0x20 || b == 0x7f {
+			return false
+		}
+	}
+
+	//the record sits in the last 64KiB (comments are at most 0xffff bytes)
+	tail := pt
+	if len(tail) > 0xffff+22 {
+		tail = tail[len(tail)-0xffff-22:]
+	}
+	return bytes.Contains(tail, []byte("PK\x05\x06"))
+}
+
+//validPDF checks the header version and the end of file marker
+func validPDF(pt []byte) bool {
+	if len(pt) < 9 || !bytes.HasPrefix(pt, Magic["pdf"].Text) || pt[7] < '0' || pt[7] > '7' {
+		return false
+	}
+
+	tail := pt
+	if len(tail) > 1024 {
+		tail = tail[len(tail)-1024:]
+	}
+	return bytes.Contains(tail, []byte("%%EOF"))
+}
+
+//validELF checks the identification bytes of the header
+func validELF(pt []byte) bool {
+	if len(pt) < 16 || !bytes.HasPrefix(pt, Magic["elf"].Text) {
+		return false
+	}
+
+	class, data, version := pt[4], pt[5], pt[6]
+	return (class == 1 || class == 2) && (data == 1 || data == 2) && version == 1 && bytes.Equal(pt[9:16], make([]byte, 7))
+}
+
+//validGzip checks the member header and that the start of the stream inflates. The
+//header alone is mostly zeros, which is what a wrong key gives in Binary mode too.
+func validGzip(pt []byte) bool {
+	if len(pt) < 18 || !bytes.HasPrefix(pt, Magic["gzip"].Text) {
+		return false
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(pt))
+	if err != nil {
+		return false
+	}
+	//a truncated but otherwise well formed stream is fine
+	_, err = io.CopyN(ioutil.Discard, r, 4096)
+	return err == nil || err == io.EOF || err == io.ErrUnexpectedEOF
+}
+
+//validJPEG checks the start and end of image markers
+func validJPEG(pt []byte) bool {
+	return len(pt) > 4 && bytes.HasPrefix(pt, Magic["jpeg"].Text) && bytes.HasSuffix(bytes.TrimRight(pt, "\x00"), []byte("\xff\xd9"))
+}
+
+//validGIF checks the full version in the signature and the trailer
+func validGIF(pt []byte) bool {
+	return len(pt) > 13 && (bytes.HasPrefix(pt, []byte("GIF87a")) || bytes.HasPrefix(pt, []byte("GIF89a"))) && pt[len(pt)-1] == 0x3b
+}
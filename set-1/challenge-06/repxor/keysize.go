@@ -0,0 +1,112 @@
+package repxor
+
+import (
+	"errors"
+	"math/bits"
+	"sort"
+)
+
+//ErrTooShort is returned when a ciphertext does not hold two blocks of any key size
+//in the requested range
+var ErrTooShort = errors.New("repxor: ciphertext too short for the key sizes asked for")
+
+//KeySize is a candidate key length. Score is higher for more likely sizes.
+type KeySize struct {
+	Size  int
+	Score float64
+}
+
+//Hamming calculates the hamming distance (number of differing bits) between two
+//buffers of the same length.
+func Hamming(a, b []byte) (int, error) {
+	if len(a) != len(b) {
+		return -1, errors.New("repxor: parameters are not of the same length")
+	}
+
+	score := 0
+	for i, x := range a {
+		//we xor the 2 values since we only care about the bits that are different
+		score += bits.OnesCount8(x ^ b[i])
+	}
+
+	return score, nil
+}
+
+//checkRange validates a key size range against the length of ctxt and returns the
+//largest size that leaves at least blocks whole blocks to compare.
+func checkRange(ctxt []byte, min, max, blocks int) (int, error) {
+	if min < 1 || max < min {
+		return 0, errors.New("repxor: invalid key size range")
+	}
+
+	if max > len(ctxt)/blocks {
+		max = len(ctxt) / blocks
+	}
+	if max < min {
+		return 0, ErrTooShort
+	}
+
+	return max, nil
+}
+
+//hammingBytes caps how much of ctxt HammingKeySizes compares, the pairs of blocks
+//grow with its square
+const hammingBytes = 4096
+
+//HammingKeySizes ranks every key size from min to max, best first. The score of a
+//size is one minus the normalised Hamming distance (bits per bit) averaged over every
+//pair of KEYSIZE blocks of ctxt. Bytes encrypted with the same key byte differ in
+//fewer bits than random ones, so the right size scores highest. Past hammingBytes,
+//blocks that add up to about that much are taken evenly spread over ctxt.
+func HammingKeySizes(ctxt []byte, min, max int) ([]KeySize, error) {
+	max, err := checkRange(ctxt, min, max, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make([]KeySize, 0, max-min+1)
+	for size := min; size <= max; size++ {
+		blocks := hammingSample(ctxt, size)
+		total, pairs := 0, 0
+
+		for i := range blocks {
+			for j := i + 1; j < len(blocks); j++ {
+				dst, _ := Hamming(blocks[i], blocks[j])
+				total += dst
+				pairs++
+			}
+		}
+
+		dist := float64(total) / float64(pairs*size*8)
+		sizes = append(sizes, KeySize{Size: size, Score: 1 - dist})
+	}
+
+	rank(sizes)
+	return sizes, nil
+}
+
+//hammingSample returns the whole size byte blocks of ctxt, or hammingBytes worth of
+//them evenly spread
+func hammingSample(ctxt []byte, size int) [][]byte {
+	n := len(ctxt) / size
+	max := n
+	if max*size > hammingBytes {
+		max = hammingBytes / size
+	}
+	if max < 2 {
+		max = 2
+	}
+
+	blocks := make([][]byte, 0, max)
+	for i := 0; i < max; i++ {
+		//spread over all n blocks, i*n/max is at most n-1
+		b := i * n / max
+		blocks = append(blocks, ctxt[b*size:(b+1)*size])
+	}
+	return blocks
+}
+
+//rank sorts sizes best first, smaller sizes win ties
+func rank(sizes []KeySize) {
+	sort.SliceStable(sizes, func(i, j int) bool { return sizes[i].Score > sizes[j].Score })
+}
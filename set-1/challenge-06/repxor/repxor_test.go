@@ -0,0 +1,339 @@
+package repxor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"hash/crc32"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"cryptopals/set-1/challenge-02/xor"
+	"cryptopals/set-1/challenge-03/score"
+)
+
+//plaintext borrowed from the challenge description
+const sample = `This challenge isn't conceptually hard, but it involves actual error-prone
+coding. The other challenges in this set are there to bring you up to speed.
+This one is there to qualify you. If you can do this one, you're probably just
+fine up to Set 6. There's a file here. It's been base64'd after being encrypted
+with repeating-key XOR. Decrypt it.`
+
+func encrypt(t *testing.T, pt, key string) []byte {
+	ct, err := xor.Repeating([]byte(pt), []byte(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ct
+}
+
+func TestHamming(t *testing.T) {
+	val, err := Hamming([]byte("this is a test"), []byte("wokka wokka!!!"))
+	if err != nil || val != 37 {
+		t.Errorf("Expected 37, got %d (%v)", val, err)
+	}
+}
+
+func TestHammingKeySizes(t *testing.T) {
+	ct := encrypt(t, sample, "SUBMARINE")
+
+	sizes, err := HammingKeySizes(ct, 2, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sizes) != 39 {
+		t.Errorf("Expected 39 sizes, got %d", len(sizes))
+	}
+	//multiples of the key size line up with the key just as well
+	if sizes[0].Size%9 != 0 {
+		t.Errorf("Expected a multiple of 9, got %+v", sizes[:3])
+	}
+
+	//only sizes that fit twice are ranked
+	sizes, err = HammingKeySizes(ct[:20], 2, 40)
+	if err != nil || len(sizes) != 9 {
+		t.Errorf("Expected sizes 2 to 10, got %d sizes (%v)", len(sizes), err)
+	}
+
+	//a long ciphertext is sampled, spread over all of it
+	words := strings.Fields(sample)
+	rng := rand.New(rand.NewSource(1))
+	var text []string
+	for len(text) < 20000 {
+		text = append(text, words[rng.Intn(len(words))])
+	}
+	long := encrypt(t, strings.Join(text, " "), "SUBMARINE")
+	if blocks := hammingSample(long, 9); len(blocks) != hammingBytes/9 || &blocks[len(blocks)-1][0] == &long[len(blocks)*9-9] {
+		t.Errorf("Expected %d blocks spread over the ciphertext, got %d", hammingBytes/9, len(blocks))
+	}
+	if sizes, err := HammingKeySizes(long, 2, 40); err != nil || sizes[0].Size%9 != 0 {
+		t.Errorf("Expected a multiple of 9 on a long ciphertext, got %+v (%v)", sizes[:3], err)
+	}
+
+	if _, err := HammingKeySizes(ct[:3], 2, 40); err != ErrTooShort {
+		t.Errorf("Expected %v, got %v", ErrTooShort, err)
+	}
+	if _, err := HammingKeySizes(ct, 0, 40); err == nil {
+		t.Errorf("Expected an error for a zero key size")
+	}
+}
+
+func TestEstimators(t *testing.T) {
+	file, _ := ioutil.ReadFile("../file.txt")
+	ct, _ := base64.StdEncoding.DecodeString(string(file))
+
+	estimators := map[string]Estimator{
+		"coincidence": ByCoincidence,
+		"kasiski":     Kasiski{},
+		"vote":        DefaultVote,
+	}
+
+	for name, e := range estimators {
+		sizes, err := e.KeySizes(ct[:600], 2, 40)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if sizes[0].Size != 29 {
+			t.Errorf("%s: Expected 29, got %+v", name, sizes[:3])
+		}
+	}
+
+	if _, err := (Kasiski{}).KeySizes([]byte("abcdefghij"), 2, 5); err != ErrNoRepeats {
+		t.Errorf("Expected %v, got %v", ErrNoRepeats, err)
+	}
+}
+
+func TestFriedman(t *testing.T) {
+	//the plaintext alone should look like a key size of 1
+	est, err := Friedman{Kappa: EnglishKappa}.Estimate([]byte(sample))
+	if err != nil || est > 1.5 {
+		t.Errorf("Expected about 1, got %v (%v)", est, err)
+	}
+}
+
+func TestBreak(t *testing.T) {
+	ct := encrypt(t, sample, "SUBMARINE")
+
+	//Hamming distance prefers 36 and 27 on this sample, both should reduce to 9
+	report, err := Break(ct, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(report.Key) != "SUBMARINE" || string(report.Plaintext) != sample {
+		t.Errorf("Expected SUBMARINE, got %q", report.Key)
+	}
+	if len(report.Columns) != 9 || report.ReducedFrom == 0 {
+		t.Errorf("Expected 9 columns reduced from a multiple, got %d from %d", len(report.Columns), report.ReducedFrom)
+	}
+	for _, alt := range report.Alternatives {
+		if alt.KeySize == 9 || alt.Score > report.Score {
+			t.Errorf("Unexpected alternative %d scoring %v", alt.KeySize, alt.Score)
+		}
+	}
+}
+
+//about 11 bytes per column: the byte model still gets every key byte where counting
+//letters alone gets a third of them wrong
+func TestBreakLongKey(t *testing.T) {
+	key := "a rather long key of thirty!!"
+	ct := encrypt(t, sample, key)
+
+	correct := func(s score.Scorer) int {
+		report, err := Break(ct, Options{MinSize: len(key), MaxSize: len(key), Column: s})
+		if err != nil {
+			t.Fatal(err)
+		}
+		n := 0
+		for i := range key {
+			if report.Key[i] == key[i] {
+				n++
+			}
+		}
+		return n
+	}
+
+	letters := correct(score.EnglishWeighted)
+	if model := correct(nil); model != len(key) || model <= letters {
+		t.Errorf("Expected all %d key bytes with the default scorer against %d with letter frequencies, got %d", len(key), letters, model)
+	}
+}
+
+func TestBreakOptions(t *testing.T) {
+	ct := encrypt(t, sample, "SUBMARINE")
+
+	for _, opts := range []Options{
+		{Candidates: -1},
+		{Tolerance: -0.1},
+		{Tolerance: 1},
+		{Tolerance: 0.1, ExactPeriod: true},
+	} {
+		if _, err := Break(ct, opts); err == nil {
+			t.Errorf("%+v: expected an error", opts)
+		}
+	}
+
+	//an exact period still finds a key that repeats exactly
+	report, err := Break(ct, Options{ExactPeriod: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(report.Key) != "SUBMARINE" {
+		t.Errorf("Expected SUBMARINE with an exact period, got %q", report.Key)
+	}
+}
+
+func TestFindKeyOp(t *testing.T) {
+	ct := encrypt(t, sample, "SUBMARINE")
+	s := score.EnglishModel().WithOrder(1)
+
+	//with XOR as the operation it is FindKey
+	want, _ := FindKey(ct, 9, s)
+	got := FindKeyOp(ct, 9, 256, func(c, k byte) byte { return c ^ k }, s)
+	if !bytes.Equal(got, want) {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestPeriod(t *testing.T) {
+	if p := Period([]byte("ICEICEICE"), 2, 0); p != 3 {
+		t.Errorf("Expected 3, got %d", p)
+	}
+	//one wrong byte out of nine is tolerated
+	if p := Period([]byte("ICEICXICE"), 2, 0.25); p != 3 {
+		t.Errorf("Expected 3, got %d", p)
+	}
+	if p := Period([]byte("SUBMARINE"), 2, 0.25); p != 9 {
+		t.Errorf("Expected 9, got %d", p)
+	}
+}
+
+func TestCribKey(t *testing.T) {
+	png := append([]byte(nil), Magic["png"].Text...)
+	for i := 0; i < 200; i++ {
+		png = append(png, byte(i*i), 0, 0)
+	}
+	key := []byte{0x13, 0x37, 0xca, 0xfe, 0xba, 0xbe, 0x00, 0x42}
+	ct, _ := xor.Repeating(png, key)
+
+	got, known, err := CribKey(ct, 8, []Crib{Magic["png"]})
+	if err != nil || string(got) != string(key) {
+		t.Errorf("Expected %x, got %x (%v)", key, got, err)
+	}
+	for i, k := range known {
+		if !k {
+			t.Errorf("Key byte %d should be known", i)
+		}
+	}
+
+	//a 16 byte crib covers a 7 byte key twice over and cannot agree with it
+	if _, _, err := CribKey(ct, 7, []Crib{Magic["png"]}); err == nil {
+		t.Errorf("Expected a conflict for key size 7")
+	} else if _, ok := err.(*ConflictError); !ok {
+		t.Errorf("Expected a *ConflictError, got %T", err)
+	}
+
+	if _, _, err := CribKey(ct, 8, []Crib{{Offset: -2, Text: []byte("abc")}}); err == nil {
+		t.Errorf("Expected an error for a crib past the end")
+	}
+}
+
+func TestBreakWithCribs(t *testing.T) {
+	key := "a key twenty long!!!"
+	ct := encrypt(t, sample, key)
+	crib := Crib{Offset: 0, Text: []byte(sample[:16])}
+
+	report, err := Break(ct, Options{MinSize: 20, MaxSize: 20, Cribs: []Crib{crib}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(report.Key) != key {
+		t.Errorf("Expected %q, got %q", key, report.Key)
+	}
+	if !report.Columns[15].Known || report.Columns[16].Known {
+		t.Errorf("Only the first 16 columns should come from the crib")
+	}
+
+	//the crib only fits a 20 byte key where it really is
+	offsets := CribOffsets(ct, 20, []byte(sample[40:70]))
+	if len(offsets) != 1 || offsets[0] != 40 {
+		t.Errorf("Expected offset 40, got %v", offsets)
+	}
+
+	if _, err := Break(ct, Options{MinSize: 7, MaxSize: 7, Cribs: []Crib{{0, []byte(sample[:30])}}}); err != ErrNoConsistentKey {
+		t.Errorf("Expected %v, got %v", ErrNoConsistentKey, err)
+	}
+}
+
+//pngSample builds a PNG-looking file: a valid header, a body that is mostly noise
+//with a fair share of zero bytes, and zero padding
+func pngSample() []byte {
+	var buf bytes.Buffer
+	//the magic already holds the chunk length and type
+	ihdr := []byte("IHDR\x00\x00\x00\x40\x00\x00\x00\x40\x08\x06\x00\x00\x00")
+	buf.Write(Magic["png"].Text)
+	buf.Write(ihdr[4:])
+	binary.Write(&buf, binary.BigEndian, crc32.ChecksumIEEE(ihdr))
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		if rng.Intn(3) == 0 {
+			buf.WriteByte(0)
+		} else {
+			buf.WriteByte(byte(rng.Intn(256)))
+		}
+	}
+	buf.Write(make([]byte, 64))
+	return buf.Bytes()
+}
+
+func TestBreakBinary(t *testing.T) {
+	pt := pngSample()
+	if Identify(pt) != "png" {
+		t.Fatalf("Expected the sample to be identified as png, got %q", Identify(pt))
+	}
+
+	key := "\x13\x37\xbe\xef\x42\x99\x01\xfe"
+	ct := encrypt(t, string(pt), key)
+
+	report, err := Break(ct, Options{Mode: Binary, MaxSize: 16})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(report.Key) != key {
+		t.Errorf("Expected %q, got %q", key, report.Key)
+	}
+	if Identify(report.Plaintext) != "png" {
+		t.Errorf("Expected a png plaintext")
+	}
+
+	//the zero padding gives the key away on its own
+	runs := ZeroRuns(ct, 8)
+	if len(runs) == 0 || runs[0].Offset > len(pt)-64 || len(runs[0].Text) < 64 {
+		t.Errorf("Expected the trailing padding as the longest run, got %v", runs)
+	}
+
+	//auto picks binary for the png and text for prose
+	if report, err := Break(ct, Options{Mode: Auto, MaxSize: 16}); err != nil || string(report.Key) != key {
+		t.Errorf("Expected %q in auto mode, got %v", key, err)
+	}
+	if report, err := Break(encrypt(t, sample, "SUBMARINE"), Options{Mode: Auto}); err != nil || string(report.Key) != "SUBMARINE" {
+		t.Errorf("Expected SUBMARINE in auto mode, got %v", err)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	for _, name := range Formats {
+		if _, ok := Magic[name]; !ok {
+			t.Errorf("%s: no magic", name)
+		}
+		if Validators[name] == nil {
+			t.Errorf("%s: no validator", name)
+		}
+	}
+	if len(Validators) != len(Formats) {
+		t.Errorf("Expected a validator for each of the %d formats, got %d", len(Formats), len(Validators))
+	}
+}
@@ -0,0 +1,107 @@
+package variant
+
+import (
+	"sort"
+
+	"cryptopals/set-1/challenge-02/variant"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-06/repxor"
+)
+
+//minGain is how much better, in log10 per byte, a longer key has to make the
+//plaintext to be preferred. A multiple of the right size fits the plaintext at least
+//as well, only by overfitting.
+const minGain = 0.05
+
+//Options configures Break and Detect. Zero fields get the defaults documented on each.
+type Options struct {
+	//MinSize and MaxSize bound the key sizes tried, 2 and 40 by default. Every size
+	//is tried: the estimators of repxor rely on the key being static.
+	MinSize, MaxSize int
+	//Column scores the candidates for a single key byte, the English byte model by
+	//default
+	Column score.Scorer
+	//Text scores the full plaintext of each key size, the English quadgram model by
+	//default
+	Text score.Scorer
+	//Ciphers are the variants Detect tries, variant.All by default
+	Ciphers []variant.Cipher
+}
+
+func (o *Options) defaults() {
+	if o.MinSize == 0 {
+		o.MinSize = 2
+	}
+	if o.MaxSize == 0 {
+		o.MaxSize = 40
+	}
+	if o.Column == nil {
+		o.Column = score.EnglishModel().WithOrder(1)
+	}
+	if o.Text == nil {
+		o.Text = score.EnglishModel()
+	}
+	if o.Ciphers == nil {
+		o.Ciphers = variant.All
+	}
+}
+
+//Result is a variant taken all the way to a plaintext
+type Result struct {
+	Cipher    variant.Cipher
+	Key       []byte
+	Plaintext []byte
+	//Score is what Options.Text gave Plaintext
+	Score float64
+}
+
+//Break recovers the key and plaintext of ctxt encrypted with c. It recovers a key for
+//every size and keeps the smallest one whose plaintext scores best.
+func Break(ctxt []byte, c variant.Cipher, opts Options) (*Result, error) {
+	opts.defaults()
+
+	max := opts.MaxSize
+	if max > len(ctxt)/2 {
+		max = len(ctxt) / 2
+	}
+	if opts.MinSize < 1 || max < opts.MinSize {
+		return nil, repxor.ErrTooShort
+	}
+
+	var best *Result
+	for size := opts.MinSize; size <= max; size++ {
+		found, key, err := FindKey(c, ctxt, size, opts.Column)
+		if err != nil {
+			return nil, err
+		}
+		pt, err := found.Decrypt(ctxt, key)
+		if err != nil {
+			return nil, err
+		}
+		res := &Result{Cipher: found, Key: key, Plaintext: pt, Score: opts.Text.Score(pt)}
+
+		if best == nil || res.Score > best.Score+minGain {
+			best = res
+		}
+	}
+
+	return best, nil
+}
+
+//Detect breaks ctxt with each of opts.Ciphers and returns the results best first, so
+//the first one names the variant most likely used
+func Detect(ctxt []byte, opts Options) ([]Result, error) {
+	opts.defaults()
+
+	var results []Result
+	for _, c := range opts.Ciphers {
+		res, err := Break(ctxt, c, opts)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, *res)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	return results, nil
+}
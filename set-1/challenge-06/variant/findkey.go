@@ -0,0 +1,153 @@
+//Package variant breaks the variations on repeating-key XOR of
+//set-1/challenge-02/variant. In each of them a key byte still covers a column of
+//every size-th byte, so they break like repeating-key XOR: solve each column on its
+//own, then pick the key size whose plaintext scores best.
+package variant
+
+import (
+	"errors"
+	"math"
+
+	"cryptopals/set-1/challenge-02/variant"
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-03/singlexor"
+	"cryptopals/set-1/challenge-06/repxor"
+)
+
+//ErrUnknownCipher is returned for a cipher that is none of the variants
+var ErrUnknownCipher = errors.New("variant: no way to find the key of the cipher")
+
+//FindKey recovers a key of the given size for c by scoring each column with s.
+//Variants with parameters of their own return the cipher with the parameters found.
+func FindKey(c variant.Cipher, ctxt []byte, size int, s score.Scorer) (variant.Cipher, []byte, error) {
+	switch c := c.(type) {
+	case variant.Static:
+		key, _ := repxor.FindKey(ctxt, size, s)
+		return c, key, nil
+	case variant.Positional:
+		return c, findPositional(ctxt, size, s), nil
+	case variant.Rotating:
+		return c, findRotating(ctxt, size, s), nil
+	case variant.Incrementing:
+		c, key := findIncrementing(c, ctxt, size, s)
+		return c, key, nil
+	case variant.Autokey:
+		return c, findAutokey(c, ctxt, size, s), nil
+	}
+	return nil, nil, ErrUnknownCipher
+}
+
+func findPositional(ctxt []byte, size int, s score.Scorer) []byte {
+	key := make([]byte, size)
+	for j, col := range repxor.Columns(ctxt, size) {
+		//byte t of column j is at position j + t*size
+		offs := make([]byte, len(col))
+		for t := range offs {
+			offs[t] = byte(j + t*size)
+		}
+		key[j], _ = solveAdd(col, offs, s)
+	}
+	return key
+}
+
+func findRotating(ctxt []byte, size int, s score.Scorer) []byte {
+	//the column of a key byte moves with each block, so gather by key index
+	cols := make([][]byte, size)
+	for i, b := range ctxt {
+		j := (i + i/size) % size
+		cols[j] = append(cols[j], b)
+	}
+	return solveXOR(cols, s)
+}
+
+//stepSample is the number of bytes of each of the first columns used to find Step
+const stepSample = 64
+
+//findIncrementing searches for Step first when it is zero, with the start of the
+//first two columns
+func findIncrementing(c variant.Incrementing, ctxt []byte, size int, s score.Scorer) (variant.Incrementing, []byte) {
+	cols := repxor.Columns(ctxt, size)
+
+	if c.Step == 0 {
+		best := math.Inf(-1)
+		for step := 1; step < 256; step++ {
+			total := 0.0
+			for j := 0; j < 2 && j < size; j++ {
+				col := cols[j]
+				if len(col) > stepSample {
+					col = col[:stepSample]
+				}
+				_, sc := solveAdd(col, blockOffsets(len(col), byte(step)), s)
+				total += sc
+			}
+			if total > best {
+				best, c.Step = total, byte(step)
+			}
+		}
+	}
+
+	key := make([]byte, size)
+	for j, col := range cols {
+		key[j], _ = solveAdd(col, blockOffsets(len(col), c.Step), s)
+	}
+	return c, key
+}
+
+//blockOffsets returns what Step has added to a key byte by each of n blocks
+func blockOffsets(n int, step byte) []byte {
+	offs := make([]byte, n)
+	for t := range offs {
+		offs[t] = step * byte(t)
+	}
+	return offs
+}
+
+//findAutokey relies on every byte of a column, with plaintext feedback, being the
+//first one XORed with the ciphertext before it, so undoing that leaves a column of
+//single-byte XOR. With ciphertext feedback the key only covers the first size bytes,
+//which get a single byte each to be scored on; everything after them decrypts
+//without the key.
+func findAutokey(c variant.Autokey, ctxt []byte, size int, s score.Scorer) []byte {
+	cols := make([][]byte, size)
+	for i, b := range ctxt {
+		j := i % size
+		if i >= size {
+			if c.Ciphertext {
+				break
+			}
+			//pt[i] = ct[i] ^ pt[i-size], unrolled down to the first byte of the column
+			b ^= cols[j][len(cols[j])-1]
+		}
+		cols[j] = append(cols[j], b)
+	}
+	return solveXOR(cols, s)
+}
+//solveXOR solves each column as a single-byte XOR
+func solveXOR(cols [][]byte, s score.Scorer) []byte {
+	key := make([]byte, len(cols))
+	for j, col := range cols {
+		if len(col) > 0 {
+			key[j] = singlexor.Best(col, s).Key
+		}
+	}
+	return key
+}
+
+//solveAdd finds the k that makes col[t] ^ (k + offs[t]) score best with s, lowest k
+//on ties, and returns it with its score
+func solveAdd(col, offs []byte, s score.Scorer) (byte, float64) {
+	pt := make([]byte, len(col))
+	var best byte
+	bestScore := 0.0
+
+	for k := 0; k < 256; k++ {
+		for t, b := range col {
+			pt[t] = b ^ (byte(k) + offs[t])
+		}
+		if sc := s.Score(pt); k == 0 || sc > bestScore {
+			best, bestScore = byte(k), sc
+		}
+	}
+
+	return best, bestScore
+}
@@ -0,0 +1,68 @@
+package variant
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"testing"
+
+	"cryptopals/set-1/challenge-02/variant"
+	"cryptopals/set-1/challenge-02/xor"
+)
+
+//lyrics returns the challenge 6 plaintext
+func lyrics(t *testing.T) []byte {
+	file, err := ioutil.ReadFile("../file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct, err := base64.StdEncoding.DecodeString(string(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pt, _ := xor.Repeating(ct, []byte("Terminator X: Bring the noise"))
+	return pt
+}
+
+func TestDetect(t *testing.T) {
+	pt := lyrics(t)[:1200]
+	key := []byte("VANILLA")
+
+	for _, c := range []variant.Cipher{variant.Static{}, variant.Positional{}, variant.Rotating{}, variant.Incrementing{Step: 5}, variant.Autokey{}, variant.Autokey{Ciphertext: true}} {
+		ct, err := c.Encrypt(pt, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		results, err := Detect(ct, Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		top := results[0]
+		if top.Cipher.Name() != c.Name() {
+			t.Errorf("Expected %s, detected %s", c.Name(), top.Cipher.Name())
+			continue
+		}
+
+		//ciphertext feedback leaves a single byte per key byte to score, only the
+		//plaintext after the key is certain
+		if a, ok := c.(variant.Autokey); ok && a.Ciphertext {
+			if !bytes.Equal(top.Plaintext[len(key):], pt[len(key):]) {
+				t.Errorf("%s: wrong plaintext", c.Name())
+			}
+			continue
+		}
+		if !bytes.Equal(top.Key, key) {
+			t.Errorf("%s: expected %q, got %q", c.Name(), key, top.Key)
+		}
+	}
+}
+
+//unknown is a cipher that is none of the variants
+type unknown struct{ variant.Static }
+
+func TestUnknownCipher(t *testing.T) {
+	if _, err := Break([]byte("some ciphertext"), unknown{}, Options{}); err != ErrUnknownCipher {
+		t.Errorf("Expected %v, got %v", ErrUnknownCipher, err)
+	}
+}
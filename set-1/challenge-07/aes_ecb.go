@@ -21,34 +21,38 @@ package main
 
 import (
 	"crypto/aes"
-	"encoding/base64"
+	"flag"
 	"fmt"
-	"io/ioutil"
 
-	"github.com/brsmsn/cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-07/ecb"
 )
 
 func main() {
 	//16 byte key = aes 128
 	key := []byte("YELLOW SUBMARINE")
 
-	file, err := ioutil.ReadFile("file.txt")
-	checkErr(err)
+	flag.Parse()
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{"file.txt"}
+	}
 
-	cipherTxt, _ := base64.StdEncoding.DecodeString(string(file))
+	cipherTxt, err := input.LoadOne(names, input.Auto)
+	if err != nil {
+		checkErr(err)
+		return
+	}
 
 	fmt.Println(decryptAESECBGEN(key, cipherTxt))
 }
 
 //uses ecb.go, implementation of cipher.go for ecb
 func decryptAESECBGEN(key []byte, cipherTxt []byte) string {
-	block, err := aes.NewCipher(key)
+	plaintxt, err := ecb.Decrypt(key, cipherTxt)
 	checkErr(err)
 
-	mode := ecb.NewECBDecrypter(block)
-	mode.CryptBlocks(cipherTxt, cipherTxt)
-
-	return string(cipherTxt)
+	return string(plaintxt)
 }
 
 func decryptAESECB(key []byte, cipherTxt []byte) string {
@@ -0,0 +1,109 @@
+//Package cavp reads the response files (.rsp) of the NIST Cryptographic Algorithm
+//Validation Program and checks block cipher modes against the vectors in them.
+//
+//A response file is made of # comments, [SECTION] headers such as [ENCRYPT] and
+//[DECRYPT], and vectors of NAME = value lines separated by blank lines.
+package cavp
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+//Vector is one test vector
+type Vector struct {
+	//Line is where the vector starts, 1-based
+	Line int
+	//Section is the last header before the vector, without the brackets
+	Section string
+	//Fields are the values of the vector by name, as written
+	Fields map[string]string
+}
+
+//Count returns the COUNT of v, or -1 when it has none
+func (v Vector) Count() int {
+	n, err := strconv.Atoi(v.Fields["COUNT"])
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+//Bytes decodes the hex field with the given name. A missing field is an error, an
+//empty one is not.
+func (v Vector) Bytes(name string) ([]byte, error) {
+	s, ok := v.Fields[name]
+	if !ok {
+		return nil, fmt.Errorf("cavp: line %d: no %s", v.Line, name)
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("cavp: line %d: %s: %v", v.Line, name, err)
+	}
+	return b, nil
+}
+
+//Parse reads the vectors of a response file
+func Parse(r io.Reader) ([]Vector, error) {
+	var vectors []Vector
+	var cur *Vector
+	section := ""
+
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+
+		switch {
+		case text == "" || strings.HasPrefix(text, "#"):
+			if text == "" {
+				cur = nil
+			}
+			continue
+
+		case strings.HasPrefix(text, "["):
+			if !strings.HasSuffix(text, "]") {
+				return nil, fmt.Errorf("cavp: line %d: unterminated section header", line)
+			}
+			section = text[1 : len(text)-1]
+			cur = nil
+			continue
+		}
+
+		eq := strings.IndexByte(text, '=')
+		if eq < 0 {
+			return nil, fmt.Errorf("cavp: line %d: expected NAME = value", line)
+		}
+		name, value := strings.TrimSpace(text[:eq]), strings.TrimSpace(text[eq+1:])
+
+		if cur == nil {
+			vectors = append(vectors, Vector{Line: line, Section: section, Fields: make(map[string]string)})
+			cur = &vectors[len(vectors)-1]
+		}
+		if _, dup := cur.Fields[name]; dup {
+			return nil, fmt.Errorf("cavp: line %d: %s given twice", line, name)
+		}
+		cur.Fields[name] = value
+	}
+
+	return vectors, sc.Err()
+}
+
+//ParseFile reads the vectors of the named response file
+func ParseFile(name string) ([]Vector, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	vectors, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return vectors, nil
+}
@@ -0,0 +1,147 @@
+package cavp
+
+import (
+	"crypto/aes"
+	"strings"
+	"testing"
+)
+
+const sample = `# CAVS 11.1
+# ECB test data
+
+[ENCRYPT]
+
+COUNT = 0
+KEY = 00000000000000000000000000000000
+PLAINTEXT = 80000000000000000000000000000000
+CIPHERTEXT = 3ad78e726c1ec02b7ebfe92b23d9ec34
+
+COUNT = 1
+KEY = 00000000000000000000000000000000
+PLAINTEXT = c0000000000000000000000000000000
+CIPHERTEXT = aae5939c8efdf2f04e60b9fe7117b2c2
+
+[DECRYPT]
+
+COUNT = 0
+KEY = 00000000000000000000000000000000
+CIPHERTEXT = 3ad78e726c1ec02b7ebfe92b23d9ec34
+PLAINTEXT = 80000000000000000000000000000000
+`
+
+func TestParse(t *testing.T) {
+	vectors, err := Parse(strings.NewReader(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != 3 {
+		t.Fatalf("Expected 3 vectors, got %d", len(vectors))
+	}
+
+	v := vectors[1]
+	if v.Line != 11 || v.Section != "ENCRYPT" || v.Count() != 1 || v.Fields["PLAINTEXT"] != "c0000000000000000000000000000000" {
+		t.Errorf("Unexpected vector %+v", v)
+	}
+	if vectors[2].Section != "DECRYPT" {
+		t.Errorf("Expected the last vector in DECRYPT, got %q", vectors[2].Section)
+	}
+	if _, err := v.Bytes("IV"); err == nil {
+		t.Error("Expected an error for a missing field")
+	}
+
+	for _, bad := range []string{"[ENCRYPT\n", "COUNT 0\n", "COUNT = 0\nCOUNT = 1\n"} {
+		if _, err := Parse(strings.NewReader(bad)); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
+func ecbMode(flip bool) Mode {
+	crypt := func(encrypt bool) func(key, iv, in []byte) ([]byte, error) {
+		return func(key, iv, in []byte) ([]byte, error) {
+			block, err := aes.NewCipher(key)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]byte, len(in))
+			for i := 0; i < len(in); i += 16 {
+				if encrypt {
+					block.Encrypt(out[i:i+16], in[i:i+16])
+				} else {
+					block.Decrypt(out[i:i+16], in[i:i+16])
+				}
+			}
+			if flip && len(out) > 0 {
+				out[0] ^= 1
+			}
+			return out, nil
+		}
+	}
+	return Mode{Encrypt: crypt(true), Decrypt: crypt(false)}
+}
+
+func TestRun(t *testing.T) {
+	vectors, _ := Parse(strings.NewReader(sample))
+
+	if r := Run(vectors, ecbMode(false)); r.Total != 3 || len(r.Failures) != 0 {
+		t.Errorf("Expected all 3 to pass, got %v", r)
+	}
+
+	r := Run(vectors, ecbMode(true))
+	if r.Total != 3 || len(r.Failures) != 3 || !strings.HasPrefix(r.String(), "3 of 3 vectors failed") {
+		t.Errorf("Expected all 3 to fail, got %v", r)
+	}
+}
+
+//TestPublished checks the files katgen generated against values printed in SP 800-38A
+//and the AESAVS, which do not depend on crypto/aes. The rest of their vectors only
+//agree with crypto/aes, unlike the GFSbox and KeySbox files checked in under NIST names.
+func TestPublished(t *testing.T) {
+	tests := []struct {
+		file  string
+		count int
+		ct    string
+	}{
+		{"gen-ECBVarTxt128.rsp", 0, "3ad78e726c1ec02b7ebfe92b23d9ec34"},
+		{"gen-ECBVarKey128.rsp", 0, "0edd33d3c621e546455bd8ba1418bec8"},
+		{"gen-ECBSP800-38A.rsp", 0, "3ad77bb40d7a3660a89ecaf32466ef97f5d3d58503b9699de785895a96fdbaaf" +
+			"43b1cd7f598ece23881b00e3ed0306887b0c785e27e8ad3f8223207104725dd4"},
+		{"gen-CBCSP800-38A.rsp", 0, "7649abac8119b246cee98e9b12e9197d5086cb9b507219ee95db113a917678b2" +
+			"73bed6b8e3c1743b7116e69e222295163ff1caa1681fac09120eca307586e1a7"},
+		{"gen-CBCSP800-38A.rsp", 2, "f58c4c04d6e5f1ba779eabfb5f7bfbd69cfc4e967edb808d679f777bc6702c7d" +
+			"39f23369a9d9bacfa530e26304231461b2eb05e2c39be9fcda6c19078c6a9d1b"},
+	}
+
+	for _, tt := range tests {
+		vectors, err := ParseFile("testdata/" + tt.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		found := false
+		for _, v := range vectors {
+			if v.Section == "ENCRYPT" && v.Count() == tt.count {
+				found = v.Fields["CIPHERTEXT"] == tt.ct
+			}
+		}
+		if !found {
+			t.Errorf("%s COUNT = %d: expected CIPHERTEXT = %s", tt.file, tt.count, tt.ct)
+		}
+	}
+}
+
+//TestNIST checks that the NIST files are all there, in ENCRYPT and DECRYPT
+func TestNIST(t *testing.T) {
+	counts := map[string]int{"GFSbox128": 7, "GFSbox192": 6, "GFSbox256": 5, "KeySbox128": 21, "KeySbox192": 24, "KeySbox256": 16}
+
+	for _, mode := range []string{"ECB", "CBC"} {
+		for test, n := range counts {
+			vectors, err := ParseFile("testdata/" + mode + test + ".rsp")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(vectors) != 2*n {
+				t.Errorf("%s%s: expected %d vectors, got %d", mode, test, 2*n, len(vectors))
+			}
+		}
+	}
+}
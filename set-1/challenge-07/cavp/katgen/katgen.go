@@ -0,0 +1,171 @@
+/*
+KAT generator
+Writes the known-answer response files that supplement NIST's in testdata. The
+GFSbox and KeySbox vectors of the AESAVS are checked in under their NIST names, e.g.
+ECBGFSbox128.rsp, transcribed from the appendices of the AESAVS. The VarTxt and VarKey
+vectors are fully determined by their definition, a zero key (or plaintext) and one
+more leading 1 bit per vector, and the SP 800-38A F.1 and F.2 vectors by the key, IV
+and plaintext printed in the standard, so their expected values are computed here
+with crypto/aes.
+
+The files are named gen-, e.g. gen-CBCVarKey192.rsp, so they are never mistaken for
+NIST's own. They check that a mode agrees with crypto/aes, not with NIST: only the
+first vectors of each file are compared with the published values, by TestPublished.
+The MMT vectors use random values only published in NIST's files and are not in the
+tree, drop those files in the same directory, under their NIST names, and the tests
+run them too.
+
+Usage:
+
+	katgen [-dir testdata]
+*/
+
+package main
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := flag.String("dir", "testdata", "directory to write the files to")
+	flag.Parse()
+
+	for _, mode := range []string{"ECB", "CBC"} {
+		for _, bits := range []int{128, 192, 256} {
+			checkErr(write(*dir, mode, "VarTxt", bits, varTxt(bits)))
+			checkErr(write(*dir, mode, "VarKey", bits, varKey(bits)))
+		}
+		checkErr(write(*dir, mode, "SP800-38A", 0, sp80038a()))
+	}
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
+	}
+}
+
+//input is the key and plaintext of a vector, the IV is always zero except for SP
+//800-38A
+type input struct {
+	key, iv, plaintext []byte
+}
+
+//leadingOnes returns n bytes whose first ones bits are set
+func leadingOnes(n, ones int) []byte {
+	b := make([]byte, n)
+	for i := 0; i < ones; i++ {
+		b[i/8] |= 0x80 >> uint(i%8)
+	}
+	return b
+}
+
+func varTxt(bits int) []input {
+	var in []input
+	for i := 1; i <= 128; i++ {
+		in = append(in, input{key: make([]byte, bits/8), iv: make([]byte, 16), plaintext: leadingOnes(16, i)})
+	}
+	return in
+}
+
+func varKey(bits int) []input {
+	var in []input
+	for i := 1; i <= bits; i++ {
+		in = append(in, input{key: leadingOnes(bits/8, i), iv: make([]byte, 16), plaintext: make([]byte, 16)})
+	}
+	return in
+}
+
+func unhex(s string) []byte {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+//sp80038a returns the AES-128, 192 and 256 examples of SP 800-38A appendix F
+func sp80038a() []input {
+	iv := unhex("000102030405060708090a0b0c0d0e0f")
+	pt := unhex("6bc1bee22e409f96e93d7e117393172a" + "ae2d8a571e03ac9c9eb76fac45af8e51" +
+		"30c81c46a35ce411e5fbc1191a0a52ef" + "f69f2445df4f9b17ad2b417be66c3710")
+
+	var in []input
+	for _, key := range []string{
+		"2b7e151628aed2a6abf7158809cf4f3c",
+		"8e73b0f7da0e6452c810f32b809079e562f8ead2522c6b7b",
+		"603deb1015ca71be2b73aef0857d77811f352c073b6108d72d9810a30914dff4",
+	} {
+		in = append(in, input{key: unhex(key), iv: iv, plaintext: pt})
+	}
+	return in
+}
+
+//encrypt computes the ciphertext of in with the standard library
+func encrypt(mode string, in input) []byte {
+	block, err := aes.NewCipher(in.key)
+	if err != nil {
+		panic(err)
+	}
+
+	ct := make([]byte, len(in.plaintext))
+	if mode == "CBC" {
+		cipher.NewCBCEncrypter(block, in.iv).CryptBlocks(ct, in.plaintext)
+		return ct
+	}
+	for i := 0; i < len(ct); i += aes.BlockSize {
+		block.Encrypt(ct[i:i+aes.BlockSize], in.plaintext[i:i+aes.BlockSize])
+	}
+	return ct
+}
+
+//write writes the vectors in a file named gen- and then as NIST names theirs, e.g.
+//gen-CBCVarKey192.rsp
+func write(dir, mode, test string, bits int, inputs []input) error {
+	name := "gen-" + mode + test
+	if bits != 0 {
+		name += fmt.Sprint(bits)
+	}
+	f, err := os.Create(filepath.Join(dir, name+".rsp"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	fmt.Fprintln(w, "# Generated by katgen, expected values computed with crypto/aes")
+	fmt.Fprintf(w, "# %s test data for %s\n", test, mode)
+	fmt.Fprintln(w, "# State : Encrypt and Decrypt")
+	if bits != 0 {
+		fmt.Fprintf(w, "# Key Length : %d\n", bits)
+	}
+
+	for _, section := range []string{"ENCRYPT", "DECRYPT"} {
+		fmt.Fprintf(w, "\n[%s]\n", section)
+		for i, in := range inputs {
+			fmt.Fprintf(w, "\nCOUNT = %d\nKEY = %x\n", i, in.key)
+			if mode == "CBC" {
+				fmt.Fprintf(w, "IV = %x\n", in.iv)
+			}
+			if section == "ENCRYPT" {
+				fmt.Fprintf(w, "PLAINTEXT = %x\nCIPHERTEXT = %x\n", in.plaintext, encrypt(mode, in))
+			} else {
+				fmt.Fprintf(w, "CIPHERTEXT = %x\nPLAINTEXT = %x\n", encrypt(mode, in), in.plaintext)
+			}
+		}
+	}
+	fmt.Fprintln(w)
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,112 @@
+package cavp
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+//Mode is a block cipher mode under test. Both functions take whole blocks and no
+//padding, iv is nil for modes without one.
+type Mode struct {
+	Encrypt func(key, iv, plaintext []byte) ([]byte, error)
+	Decrypt func(key, iv, ciphertext []byte) ([]byte, error)
+}
+
+//Failure is a vector the mode got wrong
+type Failure struct {
+	Vector Vector
+	Got    []byte
+	Err    error
+}
+
+func (f Failure) String() string {
+	what := fmt.Sprintf("got %x", f.Got)
+	if f.Err != nil {
+		what = f.Err.Error()
+	}
+	return fmt.Sprintf("line %d, %s COUNT = %d: %s", f.Vector.Line, f.Vector.Section, f.Vector.Count(), what)
+}
+
+//Report is the outcome of Run
+type Report struct {
+	Total    int
+	Failures []Failure
+}
+
+//maxListed is how many failures String lists
+const maxListed = 5
+
+func (r Report) String() string {
+	if len(r.Failures) == 0 {
+		return fmt.Sprintf("all %d vectors passed", r.Total)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d of %d vectors failed", len(r.Failures), r.Total)
+	for i, f := range r.Failures {
+		if i == maxListed {
+			fmt.Fprintf(&b, "\n\t... and %d more", len(r.Failures)-maxListed)
+			break
+		}
+		fmt.Fprintf(&b, "\n\t%v", f)
+	}
+	return b.String()
+}
+
+//Run checks m against vectors. Vectors of an ENCRYPT section are encrypted from
+//PLAINTEXT and expected to give CIPHERTEXT, those of a DECRYPT section the other way
+//around. Vectors of other sections are skipped, IV is optional.
+func Run(vectors []Vector, m Mode) Report {
+	var r Report
+
+	for _, v := range vectors {
+		var crypt func(key, iv, in []byte) ([]byte, error)
+		from, to := "PLAINTEXT", "CIPHERTEXT"
+		switch v.Section {
+		case "ENCRYPT":
+			crypt = m.Encrypt
+		case "DECRYPT":
+			crypt = m.Decrypt
+			from, to = to, from
+		default:
+			continue
+		}
+		r.Total++
+
+		got, ok, err := runOne(v, crypt, from, to)
+		if !ok {
+			r.Failures = append(r.Failures, Failure{Vector: v, Got: got, Err: err})
+		}
+	}
+
+	return r
+}
+
+//runOne returns what crypt gave and whether it was expected
+func runOne(v Vector, crypt func(key, iv, in []byte) ([]byte, error), from, to string) ([]byte, bool, error) {
+	key, err := v.Bytes("KEY")
+	if err != nil {
+		return nil, false, err
+	}
+	var iv []byte
+	if _, ok := v.Fields["IV"]; ok {
+		if iv, err = v.Bytes("IV"); err != nil {
+			return nil, false, err
+		}
+	}
+	in, err := v.Bytes(from)
+	if err != nil {
+		return nil, false, err
+	}
+	want, err := v.Bytes(to)
+	if err != nil {
+		return nil, false, err
+	}
+
+	got, err := crypt(key, iv, in)
+	if err != nil {
+		return nil, false, err
+	}
+	return got, bytes.Equal(got, want), nil
+}
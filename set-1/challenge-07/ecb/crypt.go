@@ -0,0 +1,50 @@
+package ecb
+
+import (
+	"crypto/aes"
+	"errors"
+
+	"cryptopals/set-2/challenge-09/pkcs7"
+)
+
+//ErrNotFullBlocks is returned by Decrypt for a ciphertext that is not a whole number
+//of blocks
+var ErrNotFullBlocks = errors.New("ecb: ciphertext is not a whole number of blocks")
+
+//ErrPadding is returned by Decrypt when the plaintext does not end in valid PKCS#7
+//padding, usually because the key is wrong
+var ErrPadding = errors.New("ecb: invalid padding")
+
+//Encrypt pads plaintext with PKCS#7 and encrypts it under AES in ECB mode. The key
+//must be 16, 24 or 32 bytes.
+func Encrypt(key, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	ct := pkcs7.Pad(plaintext, block.BlockSize())
+	NewECBEncrypter(block).CryptBlocks(ct, ct)
+	return ct, nil
+}
+
+//Decrypt reverses Encrypt
+func Decrypt(key, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+		return nil, ErrNotFullBlocks
+	}
+
+	pt := make([]byte, len(ciphertext))
+	//crypto/aes blocks are safe for concurrent use
+	NewParallelECBDecrypter(block).CryptBlocks(pt, ciphertext)
+
+	pt, err = pkcs7.Unpad(pt, block.BlockSize())
+	if err != nil {
+		return nil, ErrPadding
+	}
+	return pt, nil
+}
@@ -1,10 +1,19 @@
+//Package ecb implements the electronic codebook mode, which encrypts every block on
+//its own, as a cipher.BlockMode, with one-shot helpers for AES with PKCS#7 padding and
+//wrappers for streams.
 package ecb
 
-import "crypto/cipher"
+import (
+	"crypto/cipher"
+	"runtime"
+	"sync"
+)
 
 type ecb struct {
 	b         cipher.Block
 	blockSize int
+	//parallel lets the decrypter call b from several goroutines at once
+	parallel bool
 }
 
 func newECB(b cipher.Block) *ecb {
@@ -49,10 +58,22 @@ type ecbDecAble interface {
 	NewECBDecrypter(b cipher.Block) cipher.BlockMode
 }
 
+//NewECBDecrypter returns a cipher.BlockMode that decrypts in ECB mode with b, one
+//block after the other
 func NewECBDecrypter(b cipher.Block) cipher.BlockMode {
 	return (*ecbDecrypter)(newECB(b))
 }
 
+//NewParallelECBDecrypter is NewECBDecrypter splitting inputs of parallelSize bytes or
+//more across GOMAXPROCS goroutines. They call b.Decrypt at the same time, so b must be
+//safe for concurrent use, as those of crypto/aes are. The cipher.Block interface does
+//not promise it.
+func NewParallelECBDecrypter(b cipher.Block) cipher.BlockMode {
+	x := newECB(b)
+	x.parallel = true
+	return (*ecbDecrypter)(x)
+}
+
 func (x *ecbDecrypter) BlockSize() int {
 	return x.blockSize
 }
@@ -68,9 +89,45 @@ func (x *ecbDecrypter) CryptBlocks(dst, src []byte) {
 		return
 	}
 
+	if x.parallel && len(src) >= parallelSize {
+		x.cryptParallel(dst, src)
+		return
+	}
+
 	for len(src) > 0 {
 		x.b.Decrypt(dst[:x.blockSize], src[:x.blockSize])
 		src = src[x.blockSize:]
 		dst = dst[x.blockSize:]
 	}
 }
+
+//parallelSize is the input size from which a parallel decrypter splits the work
+//across goroutines, below it they cost more than they save
+const parallelSize = 256 << 10
+
+//cryptParallel decrypts src in as many parts as there are CPUs. Blocks do not depend
+//on each other in ECB, see NewParallelECBDecrypter for what the cipher.Block needs.
+func (x *ecbDecrypter) cryptParallel(dst, src []byte) {
+	blocks := len(src) / x.blockSize
+	workers := runtime.GOMAXPROCS(0)
+	per := (blocks + workers - 1) / workers * x.blockSize
+
+	var wg sync.WaitGroup
+	for start := 0; start < len(src); start += per {
+		end := start + per
+		if end > len(src) {
+			end = len(src)
+		}
+
+		wg.Add(1)
+		go func(dst, src []byte) {
+			defer wg.Done()
+			for len(src) > 0 {
+				x.b.Decrypt(dst[:x.blockSize], src[:x.blockSize])
+				src = src[x.blockSize:]
+				dst = dst[x.blockSize:]
+			}
+		}(dst[start:end], src[start:end])
+	}
+	wg.Wait()
+}
@@ -0,0 +1,159 @@
+package ecb
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"io/ioutil"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"testing/iotest"
+
+	"cryptopals/set-1/challenge-01/input"
+)
+
+var key = []byte("YELLOW SUBMARINE")
+
+func TestDecrypt(t *testing.T) {
+	ct, err := input.LoadOne([]string{"../file.txt"}, input.Base64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pt, err := Decrypt(key, ct)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(pt), "I'm back and I'm ringin' the bell") || !strings.HasSuffix(string(pt), "Play that funky music \n") {
+		t.Errorf("Unexpected plaintext %q...%q", pt[:40], pt[len(pt)-40:])
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for n := 0; n < 50; n++ {
+		pt := bytes.Repeat([]byte{'A'}, n)
+		ct, err := Encrypt(key, pt)
+		if err != nil || len(ct) != (n/16+1)*16 {
+			t.Fatalf("%d bytes: got %d bytes of ciphertext (%v)", n, len(ct), err)
+		}
+		got, err := Decrypt(key, ct)
+		if err != nil || !bytes.Equal(got, pt) {
+			t.Errorf("%d bytes: round trip gave %q (%v)", n, got, err)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := Encrypt([]byte("short"), nil); err == nil {
+		t.Error("Expected an error for a 5 byte key")
+	}
+	if _, err := Decrypt(key, make([]byte, 17)); err != ErrNotFullBlocks {
+		t.Errorf("Expected ErrNotFullBlocks, got %v", err)
+	}
+
+	ct, _ := Encrypt(key, []byte("YELLOW SUBMARINE"))
+	if _, err := Decrypt([]byte("ORANGE SUBMARINE"), ct); err != ErrPadding {
+		t.Errorf("Expected ErrPadding under the wrong key, got %v", err)
+	}
+}
+
+func TestParallel(t *testing.T) {
+	block, _ := aes.NewCipher(key)
+	src := make([]byte, parallelSize+16*1001)
+	rand.New(rand.NewSource(1)).Read(src)
+
+	want := make([]byte, len(src))
+	for i := 0; i < len(src); i += 16 {
+		block.Decrypt(want[i:i+16], src[i:i+16])
+	}
+
+	got := make([]byte, len(src))
+	NewParallelECBDecrypter(block).CryptBlocks(got, src)
+	if !bytes.Equal(got, want) {
+		t.Error("Parallel decryption differs from block by block")
+	}
+}
+
+//serialBlock is a cipher.Block that is not safe for concurrent use and records when
+//it is used that way anyway
+type serialBlock struct {
+	cipher.Block
+	busy, overlapped int32
+}
+
+func (b *serialBlock) Decrypt(dst, src []byte) {
+	if !atomic.CompareAndSwapInt32(&b.busy, 0, 1) {
+		atomic.StoreInt32(&b.overlapped, 1)
+		return
+	}
+	b.Block.Decrypt(dst, src)
+	//let any other goroutine in while busy, even on a single CPU
+	runtime.Gosched()
+	atomic.StoreInt32(&b.busy, 0)
+}
+
+func TestNotParallel(t *testing.T) {
+	des, _ := des.NewCipher([]byte("8bytekey"))
+	block := &serialBlock{Block: des}
+	src := make([]byte, parallelSize+8*1001)
+	rand.New(rand.NewSource(1)).Read(src)
+
+	want := make([]byte, len(src))
+	for i := 0; i < len(src); i += 8 {
+		des.Decrypt(want[i:i+8], src[i:i+8])
+	}
+
+	//past parallelSize the plain decrypter still calls the block one at a time, even
+	//with several CPUs to spread the work over
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(4))
+	got := make([]byte, len(src))
+	NewECBDecrypter(block).CryptBlocks(got, src)
+	if block.overlapped != 0 || !bytes.Equal(got, want) {
+		t.Error("Decrypter used a block from several goroutines at once")
+	}
+}
+
+func TestStream(t *testing.T) {
+	block, _ := aes.NewCipher(key)
+	rng := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{0, 15, 16, 1000, 3*streamChunk + 5} {
+		pt := make([]byte, n)
+		rng.Read(pt)
+
+		//odd sized writes
+		var ct bytes.Buffer
+		w := NewEncryptWriter(block, &ct)
+		for rest := pt; len(rest) > 0; {
+			k := rng.Intn(100000) + 1
+			if k > len(rest) {
+				k = len(rest)
+			}
+			if _, err := w.Write(rest[:k]); err != nil {
+				t.Fatal(err)
+			}
+			rest = rest[k:]
+		}
+		if err := w.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		want, _ := Encrypt(key, pt)
+		if !bytes.Equal(ct.Bytes(), want) {
+			t.Errorf("%d bytes: the writer and Encrypt differ", n)
+		}
+
+		got, err := ioutil.ReadAll(NewDecryptReader(block, iotest.HalfReader(&ct)))
+		if err != nil || !bytes.Equal(got, pt) {
+			t.Errorf("%d bytes: the reader did not give the plaintext back (%v)", n, err)
+		}
+	}
+
+	if _, err := ioutil.ReadAll(NewDecryptReader(block, bytes.NewReader(make([]byte, 40)))); err != ErrNotFullBlocks {
+		t.Errorf("Expected ErrNotFullBlocks, got %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package ecb
+
+import (
+	"crypto/aes"
+	"path/filepath"
+	"testing"
+
+	"cryptopals/set-1/challenge-07/cavp"
+)
+
+func crypt(encrypt bool) func(key, iv, in []byte) ([]byte, error) {
+	return func(key, iv, in []byte) ([]byte, error) {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		mode := NewECBDecrypter(block)
+		if encrypt {
+			mode = NewECBEncrypter(block)
+		}
+		out := make([]byte, len(in))
+		mode.CryptBlocks(out, in)
+		return out, nil
+	}
+}
+
+func TestKAT(t *testing.T) {
+	//katgen's gen- files and any genuine NIST ones dropped next to them
+	files, _ := filepath.Glob("../cavp/testdata/*ECB*.rsp")
+	if len(files) == 0 {
+		t.Fatal("No ECB response files")
+	}
+
+	for _, name := range files {
+		vectors, err := cavp.ParseFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := cavp.Run(vectors, cavp.Mode{Encrypt: crypt(true), Decrypt: crypt(false)})
+		if len(r.Failures) > 0 {
+			t.Errorf("%s: %v", filepath.Base(name), r)
+		}
+	}
+}
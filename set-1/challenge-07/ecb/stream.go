@@ -0,0 +1,150 @@
+package ecb
+
+import (
+	"crypto/cipher"
+	"errors"
+	"io"
+
+	"cryptopals/set-2/challenge-09/pkcs7"
+)
+
+//streamChunk is how many bytes the stream wrappers crypt at a time
+const streamChunk = 64 << 10
+
+//ErrClosed is returned by writes to a closed encrypting writer
+var ErrClosed = errors.New("ecb: write to closed writer")
+
+type encryptWriter struct {
+	w    io.Writer
+	mode cipher.BlockMode
+	//buf holds the bytes that do not fill a block yet
+	buf    []byte
+	closed bool
+}
+
+//NewEncryptWriter returns a writer that encrypts what is written to it with b in ECB
+//mode and writes the ciphertext to w. Close pads and writes the last block, it does
+//not close w.
+func NewEncryptWriter(b cipher.Block, w io.Writer) io.WriteCloser {
+	return &encryptWriter{w: w, mode: NewECBEncrypter(b)}
+}
+
+func (e *encryptWriter) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, ErrClosed
+	}
+
+	size := e.mode.BlockSize()
+	n := len(p)
+	for len(p) > 0 {
+		take := streamChunk - len(e.buf)
+		if take > len(p) {
+			take = len(p)
+		}
+		e.buf = append(e.buf, p[:take]...)
+		p = p[take:]
+
+		if len(e.buf) < streamChunk && len(p) > 0 {
+			continue
+		}
+		if err := e.flush(len(e.buf) / size * size); err != nil {
+			return n - len(p), err
+		}
+	}
+	return n, nil
+}
+
+//flush encrypts and writes the first n bytes of the buffer
+func (e *encryptWriter) flush(n int) error {
+	if n == 0 {
+		return nil
+	}
+
+	ct := make([]byte, n)
+	e.mode.CryptBlocks(ct, e.buf[:n])
+	e.buf = e.buf[:copy(e.buf, e.buf[n:])]
+	_, err := e.w.Write(ct)
+	return err
+}
+
+func (e *encryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+
+	e.buf = pkcs7.Pad(e.buf, e.mode.BlockSize())
+	return e.flush(len(e.buf))
+}
+
+type decryptReader struct {
+	r    io.Reader
+	mode cipher.BlockMode
+	//in holds ciphertext not decrypted yet, the last block is kept back until the
+	//end of r shows whether it holds the padding
+	in  []byte
+	out []byte
+	err error
+}
+
+//NewDecryptReader returns a reader that decrypts r with b in ECB mode and removes the
+//PKCS#7 padding at its end. A ciphertext that is not a whole number of blocks gives
+//ErrNotFullBlocks, bad padding ErrPadding.
+func NewDecryptReader(b cipher.Block, r io.Reader) io.Reader {
+	return &decryptReader{r: r, mode: NewECBDecrypter(b)}
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		d.fill()
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+//fill reads a chunk of r and decrypts the blocks that cannot be the last
+func (d *decryptReader) fill() {
+	size := d.mode.BlockSize()
+
+	start := len(d.in)
+	d.in = append(d.in, make([]byte, streamChunk)...)
+	n, err := io.ReadFull(d.r, d.in[start:])
+	d.in = d.in[:start+n]
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		if len(d.in) == 0 || len(d.in)%size != 0 {
+			d.err = ErrNotFullBlocks
+			return
+		}
+		pt := make([]byte, len(d.in))
+		d.mode.CryptBlocks(pt, d.in)
+		d.in = nil
+
+		d.out, d.err = pkcs7.Unpad(pt, size)
+		if d.err != nil {
+			d.err = ErrPadding
+			return
+		}
+		d.err = io.EOF
+		return
+	}
+	if err != nil {
+		d.err = err
+		return
+	}
+
+	//keep back at least one whole block
+	keep := len(d.in)%size + size
+	if keep > len(d.in) {
+		return
+	}
+	ready := len(d.in) - keep
+	d.out = make([]byte, ready)
+	d.mode.CryptBlocks(d.out, d.in[:ready])
+	d.in = d.in[:copy(d.in, d.in[ready:])]
+}
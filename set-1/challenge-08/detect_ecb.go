@@ -11,33 +11,33 @@ Remember that the problem with ECB is that it is stateless and deterministic; th
 package main
 
 import (
-	"bufio"
+	"flag"
 	"fmt"
-	"os"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-08/identify"
 )
 
 const blockSize = 16
 
 func main() {
+	flag.Parse()
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{"file.txt"}
+	}
 
-	f, err := os.Open("file.txt")
-	checkErr(err)
-
-	defer f.Close()
-
-	scanner := bufio.NewScanner(f)
-	scanner.Split(bufio.ScanLines)
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		texts := detectRepBlocks([]byte(line))
+	records, err := input.Load(names, input.Options{Lines: true})
+	if err != nil {
+		checkErr(err)
+		return
+	}
 
-		if texts {
-			fmt.Println("repeating ciphertext: \n" + line)
+	for _, r := range records {
+		if detectRepBlocks(r.Data) {
+			fmt.Printf("repeating ciphertext at %s:%d: \n%x\n", r.Name, r.Line, r.Data)
 		}
-
 	}
-
 }
 
 func checkErr(err error) {
@@ -47,20 +47,7 @@ func checkErr(err error) {
 }
 
 func detectRepBlocks(cipherTxt []byte) bool {
-
-	blocks := make(map[string]int)
-
-	for i := 0; i < len(cipherTxt); i += blockSize {
-		block := string(cipherTxt[i : i+16])
-
-		//ECB has repeating blocks, a proper mode of operation would have no
-		//duplicate blocks
-		if _, isInMap := blocks[block]; isInMap {
-			return true
-		}
-
-		blocks[block] = 1
-	}
-
-	return false
+	//ECB has repeating blocks, a proper mode of operation would have no
+	//duplicate blocks
+	return identify.RepeatedBlocks(cipherTxt, blockSize) > 0
 }
@@ -0,0 +1,195 @@
+package identify
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"cryptopals/set-1/challenge-03/score"
+	"cryptopals/set-1/challenge-03/singlexor"
+	"cryptopals/set-1/challenge-06/repxor"
+)
+
+//RepeatedBlocks returns how many of the size byte blocks of data repeat an earlier
+//block. ECB encrypts equal blocks to equal blocks, a proper mode of operation makes
+//repeats as unlikely as in random data. A short last block is ignored.
+func RepeatedBlocks(data []byte, size int) int {
+	seen := make(map[string]bool)
+	repeats := 0
+
+	for i := 0; i+size <= len(data); i += size {
+		block := string(data[i : i+size])
+		if seen[block] {
+			repeats++
+		}
+		seen[block] = true
+	}
+
+	return repeats
+}
+
+//englishness maps the English model score of text from what encoded data gets to
+//what prose gets onto 0 to 1
+func englishness(text []byte) float64 {
+	s := score.EnglishModel().Score(text)
+	return math.Max(0, math.Min(1, (s+10)/4.5))
+}
+
+//isText tells whether data is printable and how English it reads
+func isText(data []byte) (bool, float64) {
+	return (score.Printable{}).Score(data) >= printableText, englishness(data)
+}
+
+//format checks the file formats repxor knows the structure of
+func format(data []byte) *Hypothesis {
+	name := repxor.Identify(data)
+	if name == "" {
+		return nil
+	}
+	return &Hypothesis{Name: name + " file", Confidence: 0.99, Evidence: []string{"magic bytes and header fields of " + name}}
+}
+
+//plaintext checks for printable text, the more English the more confident
+func plaintext(data []byte) *Hypothesis {
+	text, english := isText(data)
+	if !text {
+		return nil
+	}
+
+	return &Hypothesis{
+		Name:       "plaintext",
+		Confidence: 0.1 + 0.83*english,
+		Evidence: []string{
+			fmt.Sprintf("%.0f%% printable", 100*(score.Printable{}).Score(data)),
+			fmt.Sprintf("index of coincidence %.4f, English is about %.3f", repxor.Coincidence(data), repxor.EnglishKappa),
+		},
+	}
+}
+
+//ecb checks for repeated 16 and 8 byte blocks
+func ecb(data []byte) *Hypothesis {
+	if text, _ := isText(data); text {
+		return nil
+	}
+
+	for _, size := range []int{16, 8} {
+		repeats := RepeatedBlocks(data, size)
+		if repeats == 0 {
+			continue
+		}
+
+		h := &Hypothesis{
+			Name:       fmt.Sprintf("ECB with %d-byte blocks", size),
+			Confidence: 0.9,
+			Evidence:   []string{fmt.Sprintf("%d of %d blocks repeat an earlier one", repeats, len(data)/size)},
+		}
+		if len(data)%size != 0 {
+			h.Confidence = 0.5
+			h.Evidence = append(h.Evidence, fmt.Sprintf("but the length is not a multiple of %d", size))
+		}
+		return h
+	}
+
+	return nil
+}
+
+//singleXOR checks whether some byte other than zero turns data into text
+func singleXOR(data []byte) *Hypothesis {
+	if text, _ := isText(data); text {
+		return nil
+	}
+
+	best := singlexor.Best(data, score.EnglishModel())
+	text, english := isText(best.Plaintext)
+	if !text || best.Key == 0 {
+		return nil
+	}
+
+	return &Hypothesis{
+		Name:       "single-byte XOR",
+		Confidence: 0.1 + 0.85*english,
+		Evidence: []string{
+			fmt.Sprintf("key %#02x gives printable text, %.2f ahead of the next key", best.Key, best.Margin),
+			fmt.Sprintf("%q", preview(best.Plaintext)),
+		},
+	}
+}
+
+//repeatingXOR checks the key size signals and whether breaking as repeating-key XOR
+//gives text
+func repeatingXOR(data []byte) *Hypothesis {
+	if text, _ := isText(data); text || len(data) < 16 {
+		return nil
+	}
+
+	report, err := repxor.Break(data, repxor.Options{})
+	if err != nil {
+		return nil
+	}
+
+	//a key of one byte repeated is single-byte XOR
+	if bytes.Count(report.Key, report.Key[:1]) == len(report.Key) {
+		return nil
+	}
+
+	text, english := isText(report.Plaintext)
+	if !text {
+		return nil
+	}
+
+	h := &Hypothesis{
+		Name:       "repeating-key XOR",
+		Confidence: 0.1 + 0.84*english,
+		Evidence:   []string{fmt.Sprintf("key of %d bytes %q gives printable text", report.KeySize, report.Key)},
+	}
+	if sizes, err := repxor.HammingKeySizes(data, 2, 40); err == nil {
+		h.Evidence = append(h.Evidence, fmt.Sprintf("Hamming distance ranks %d first", sizes[0].Size))
+	}
+	if report.ReducedFrom != 0 {
+		h.Evidence = append(h.Evidence, fmt.Sprintf("key size reduced from %d, a multiple", report.ReducedFrom))
+	}
+	if sizes, err := repxor.CoincidenceKeySizes(data, 2, 40); err == nil {
+		h.Evidence = append(h.Evidence, fmt.Sprintf("index of coincidence of the columns ranks %d first", sizes[0].Size))
+	}
+
+	return h
+}
+
+//random checks for data as flat as the output of a good cipher
+func random(data []byte) *Hypothesis {
+	if text, _ := isText(data); text {
+		return nil
+	}
+
+	//a short sample cannot reach 8 bits per byte
+	max := math.Min(8, math.Log2(float64(len(data))))
+	if max <= 0 {
+		return nil
+	}
+	flat := score.Entropy(data) / max
+	if flat < 0.85 || RepeatedBlocks(data, 16) > 0 {
+		return nil
+	}
+
+	h := &Hypothesis{
+		Name:       "random: stream cipher or compressed",
+		Confidence: 0.3 + 0.4*flat,
+		Evidence: []string{
+			fmt.Sprintf("entropy %.2f bits per byte", score.Entropy(data)),
+			fmt.Sprintf("index of coincidence %.4f, random is about %.4f", repxor.Coincidence(data), repxor.RandomKappa),
+		},
+	}
+	if len(data)%16 == 0 {
+		h.Name = "random: CBC, CTR or compressed"
+		h.Evidence = append(h.Evidence, "length is a multiple of 16 and no block repeats")
+	}
+	return h
+}
+
+//preview returns the start of text
+func preview(text []byte) []byte {
+	if len(text) > 40 {
+		return text[:40]
+	}
+	return text
+}
@@ -0,0 +1,97 @@
+//Package identify guesses what an unlabeled blob is: an encoding of something else,
+//plaintext, a known file format, single-byte or repeating-key XOR, ECB, or output
+//that looks random like CBC, CTR or compressed data. Each detector reports a
+//hypothesis with the evidence behind it and the hypotheses are ranked by confidence.
+package identify
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"cryptopals/set-1/challenge-01/input"
+)
+
+//Hypothesis is one guess at what a blob is
+type Hypothesis struct {
+	Name string
+	//Confidence is from 0 to 1
+	Confidence float64
+	Evidence   []string
+	//Inner are the hypotheses for the decoded blob when Name is an encoding
+	Inner []Hypothesis
+}
+
+//maxDepth bounds how many encodings deep Identify looks
+const maxDepth = 3
+
+//printableText is the fraction of printable bytes above which a plaintext is text
+const printableText = 0.95
+
+//Identify runs every detector on blob and returns their hypotheses, most confident
+//first
+func Identify(blob []byte) []Hypothesis {
+	return identify(blob, 0)
+}
+
+func identify(blob []byte, depth int) []Hypothesis {
+	var hyps []Hypothesis
+	if len(blob) == 0 {
+		return hyps
+	}
+
+	if depth < maxDepth {
+		hyps = append(hyps, encodings(blob, depth)...)
+	}
+
+	for _, detect := range []func([]byte) *Hypothesis{format, plaintext, ecb, singleXOR, repeatingXOR, random} {
+		if h := detect(blob); h != nil {
+			hyps = append(hyps, *h)
+		}
+	}
+
+	sort.SliceStable(hyps, func(i, j int) bool { return hyps[i].Confidence > hyps[j].Confidence })
+	return hyps
+}
+
+//Best returns the most confident hypothesis, following encodings down to what they
+//hold, e.g. "base64 > repeating-key XOR"
+func Best(hyps []Hypothesis) string {
+	if len(hyps) == 0 {
+		return "unknown"
+	}
+	if len(hyps[0].Inner) > 0 {
+		return hyps[0].Name + " > " + Best(hyps[0].Inner)
+	}
+	return hyps[0].Name
+}
+
+//encodings identifies what blob decodes to when input detects it as hex or base64
+func encodings(blob []byte, depth int) []Hypothesis {
+	enc := input.Detect(blob)
+	if enc == input.Raw {
+		return nil
+	}
+	data, _, err := input.Decode("blob", 1, blob, enc)
+	if err != nil || len(data) == 0 {
+		return nil
+	}
+
+	//hex digits are base64 characters too but input settles on hex first. Padded
+	//base64 has a length that is a multiple of 4, unpadded base64 is only as likely
+	//as any string of its characters.
+	conf, evidence := 0.97, "only hex digits, even length"
+	if enc != input.Hex {
+		conf, evidence = 0.8, "only "+enc.String()+" characters"
+		if bytes.HasSuffix(bytes.TrimSpace(blob), []byte("=")) || len(data)%3 == 0 {
+			conf, evidence = 0.95, evidence+", valid length and padding"
+		}
+	}
+
+	return []Hypothesis{{
+		Name:       enc.String(),
+		Confidence: conf,
+		Evidence:   []string{evidence, fmt.Sprintf("decodes to %d bytes", len(data))},
+		Inner:      identify(data, depth+1),
+	}}
+}
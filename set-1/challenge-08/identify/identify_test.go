@@ -0,0 +1,91 @@
+package identify
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+)
+
+//line returns line n of a challenge file
+func line(t *testing.T, name string, n int) []byte {
+	f, err := os.Open(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for i := 1; sc.Scan(); i++ {
+		if i == n {
+			return sc.Bytes()
+		}
+	}
+	t.Fatalf("%s has no line %d", name, n)
+	return nil
+}
+
+func TestIdentify(t *testing.T) {
+	challenge6, err := ioutil.ReadFile("../../challenge-06/file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	noise := make([]byte, 1024)
+	rand.New(rand.NewSource(1)).Read(noise)
+
+	text := "This one is there to qualify you. If you can do this one, you're probably just fine up to Set 6."
+
+	tests := []struct {
+		name string
+		blob []byte
+		want string
+	}{
+		{"hex text", []byte(hex.EncodeToString([]byte(text))), "hex > plaintext"},
+		{"challenge 4", line(t, "../../challenge-04/file.txt", 171), "hex > single-byte XOR"},
+		{"challenge 6", challenge6, "base64 > repeating-key XOR"},
+		{"challenge 8", line(t, "../file.txt", 133), "hex > ECB with 16-byte blocks"},
+		{"noise", noise, "random: CBC, CTR or compressed"},
+	}
+
+	for _, tt := range tests {
+		hyps := Identify(tt.blob)
+		if got := Best(hyps); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, got)
+			for _, h := range hyps {
+				t.Logf("%s %.2f %v", h.Name, h.Confidence, h.Evidence)
+			}
+		}
+	}
+}
+
+func TestEvidence(t *testing.T) {
+	challenge6, err := ioutil.ReadFile("../../challenge-06/file.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//both key size rankings are reported whatever Break made of them
+	hyps := Identify(challenge6)[0].Inner
+	if hyps[0].Name != "repeating-key XOR" {
+		t.Fatalf("Expected repeating-key XOR, got %q", hyps[0].Name)
+	}
+	for _, want := range []string{"Hamming distance ranks", "index of coincidence of the columns ranks"} {
+		found := false
+		for _, ev := range hyps[0].Evidence {
+			found = found || strings.HasPrefix(ev, want)
+		}
+		if !found {
+			t.Errorf("Expected evidence %q, got %q", want, hyps[0].Evidence)
+		}
+	}
+}
+
+func TestRepeatedBlocks(t *testing.T) {
+	if n := RepeatedBlocks([]byte("YELLOW SUBMARINEYELLOW SUBMARINEYELLOW"), 16); n != 1 {
+		t.Errorf("Expected 1 repeat, got %d", n)
+	}
+}
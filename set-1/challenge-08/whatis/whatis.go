@@ -0,0 +1,94 @@
+/*
+What is it
+Guesses what unlabeled blobs are: hex or base64 and what they decode to, plaintext,
+a known file format, single-byte or repeating-key XOR, ECB, or random looking output
+like CBC, CTR or compressed data. Every guess comes with its confidence and evidence.
+
+Usage:
+
+	whatis [-lines] [-top 3] [files...]
+
+With no files the blob is read from stdin. With -lines every line is a blob of its
+own, like the files of challenges 4 and 8.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"cryptopals/set-1/challenge-08/identify"
+)
+
+func main() {
+	lines := flag.Bool("lines", false, "identify every line on its own")
+	top := flag.Int("top", 3, "number of hypotheses to print per blob")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		checkErr(run("stdin", os.Stdin, *lines, *top))
+	}
+	for _, name := range flag.Args() {
+		f, err := os.Open(name)
+		checkErr(err)
+		checkErr(run(name, f, *lines, *top))
+		f.Close()
+	}
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error: ", err)
+		os.Exit(1)
+	}
+}
+
+//run identifies the blob in r, or each of its lines
+func run(name string, r io.Reader, lines bool, top int) error {
+	if !lines {
+		blob, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		hyps := identify.Identify(blob)
+		fmt.Printf("%s: %s\n", name, identify.Best(hyps))
+		printHyps(hyps, top, 1)
+		return nil
+	}
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	for n := 1; sc.Scan(); n++ {
+		blob := bytes.TrimSpace(sc.Bytes())
+		if len(blob) == 0 {
+			continue
+		}
+		hyps := identify.Identify(blob)
+		fmt.Printf("%s:%d: %s\n", name, n, identify.Best(hyps))
+		printHyps(hyps, top, 1)
+	}
+	return sc.Err()
+}
+
+//printHyps writes the top hypotheses with their evidence, indenting those inside an
+//encoding further
+func printHyps(hyps []identify.Hypothesis, top, depth int) {
+	indent := strings.Repeat("\t", depth)
+	for i, h := range hyps {
+		if i == top {
+			break
+		}
+		fmt.Printf("%s%.2f %s\n", indent, h.Confidence, h.Name)
+		for _, e := range h.Evidence {
+			fmt.Printf("%s\t- %s\n", indent, e)
+		}
+		printHyps(h.Inner, top, depth+1)
+	}
+}
@@ -0,0 +1,44 @@
+//Package pkcs7 pads messages to a whole number of blocks as PKCS#7 does: with n bytes
+//of value n, n from 1 to the block size, so that the padding can always be told apart
+//from the message.
+package pkcs7
+
+import "errors"
+
+//ErrPadding is returned by Unpad for data that does not end in valid padding
+var ErrPadding = errors.New("pkcs7: invalid padding")
+
+//Pad returns data followed by its padding to a multiple of size bytes. A message that
+//is already a multiple gets a whole block. size must be from 1 to 255.
+func Pad(data []byte, size int) []byte {
+	if size < 1 || size > 255 {
+		panic("pkcs7: invalid block size")
+	}
+
+	n := size - len(data)%size
+	out := make([]byte, len(data)+n)
+	copy(out, data)
+	for i := len(data); i < len(out); i++ {
+		out[i] = byte(n)
+	}
+	return out
+}
+
+//Unpad returns data without its padding. data must be a whole number of size byte
+//blocks.
+func Unpad(data []byte, size int) ([]byte, error) {
+	if size < 1 || size > 255 || len(data) == 0 || len(data)%size != 0 {
+		return nil, ErrPadding
+	}
+
+	n := int(data[len(data)-1])
+	if n == 0 || n > size {
+		return nil, ErrPadding
+	}
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, ErrPadding
+		}
+	}
+	return data[:len(data)-n], nil
+}
@@ -0,0 +1,38 @@
+package pkcs7
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPad(t *testing.T) {
+	if got := Pad([]byte("YELLOW SUBMARINE"), 20); string(got) != "YELLOW SUBMARINE\x04\x04\x04\x04" {
+		t.Errorf("Expected 4 bytes of padding, got %q", got)
+	}
+	if got := Pad([]byte("YELLOW SUBMARINE"), 16); len(got) != 32 || got[31] != 16 {
+		t.Errorf("Expected a whole block of padding, got %q", got)
+	}
+
+	for n := 0; n < 40; n++ {
+		data := bytes.Repeat([]byte{'A'}, n)
+		got, err := Unpad(Pad(data, 16), 16)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("%d bytes: round trip gave %q (%v)", n, got, err)
+		}
+	}
+}
+
+func TestUnpadInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"ICE ICE BABY\x04\x04\x04",
+		"ICE ICE BABY\x05\x05\x05\x05",
+		"ICE ICE BABY\x01\x02\x03\x04",
+		"ICE ICE BABY\x00\x00\x00\x00",
+		"ICE ICE BABYICE\x11",
+	} {
+		if _, err := Unpad([]byte(s), 16); err != ErrPadding {
+			t.Errorf("%q: expected ErrPadding, got %v", s, err)
+		}
+	}
+}
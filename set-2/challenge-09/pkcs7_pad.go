@@ -19,34 +19,13 @@ of padding to the end of the block. For instance,
 package main
 
 import (
-	"errors"
 	"fmt"
+
+	"cryptopals/set-2/challenge-09/pkcs7"
 )
 
 func main() {
 	txt := []byte("YELLOW SUBMARINE")
-	txt, _ = pkcs7Pad(txt, 20)
+	txt = pkcs7.Pad(txt, 20)
 	fmt.Println(txt)
 }
-
-func pkcs7Pad(text []byte, blockToPad int) ([]byte, error) {
-	if len(text) > blockToPad {
-		return nil, errors.New("The text to pad is already larger than the specified number")
-	}
-
-	if blockToPad < 0 {
-		return nil, errors.New("Specified number to pad can not be negative")
-	}
-
-	if blockToPad > 256 {
-		return nil, errors.New("Out of specs")
-	}
-
-	toPad := blockToPad - len(text)
-
-	for i := 0; i < toPad; i++ {
-		text = append(text, byte(toPad))
-	}
-
-	return text, nil
-}
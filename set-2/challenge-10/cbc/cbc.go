@@ -0,0 +1,106 @@
+//Package cbc implements the cipher block chaining mode as a cipher.BlockMode for any
+//block cipher: every plaintext block is XORed with the previous ciphertext block, the
+//first with the IV, before it is encrypted. Seal and Open add and remove PKCS#7
+//padding, Encrypt and Decrypt do the same for AES given a key.
+package cbc
+
+import (
+	"crypto/cipher"
+	"errors"
+
+	"cryptopals/set-1/challenge-02/xor"
+)
+
+//ErrIVSize is returned for an IV that is not one block long
+var ErrIVSize = errors.New("cbc: IV length must equal the block size")
+
+type cbc struct {
+	b         cipher.Block
+	blockSize int
+	//iv is the block the next one is chained to
+	iv  []byte
+	tmp []byte
+}
+
+func newCBC(b cipher.Block, iv []byte) (*cbc, error) {
+	if len(iv) != b.BlockSize() {
+		return nil, ErrIVSize
+	}
+	return &cbc{
+		b:         b,
+		blockSize: b.BlockSize(),
+		iv:        append([]byte(nil), iv...),
+		tmp:       make([]byte, b.BlockSize()),
+	}, nil
+}
+
+type cbcEncrypter cbc
+
+//NewCBCEncrypter returns a BlockMode that encrypts with b in CBC mode starting from
+//iv. Successive calls to CryptBlocks carry on the chain.
+func NewCBCEncrypter(b cipher.Block, iv []byte) (cipher.BlockMode, error) {
+	x, err := newCBC(b, iv)
+	if err != nil {
+		return nil, err
+	}
+	return (*cbcEncrypter)(x), nil
+}
+
+func (x *cbcEncrypter) BlockSize() int {
+	return x.blockSize
+}
+
+func (x *cbcEncrypter) CryptBlocks(dst, src []byte) {
+	if len(src)%x.blockSize != 0 {
+		panic("crypto/cipher: input not full blocks")
+	}
+	if len(dst) < len(src) {
+		panic("crypto/cipher: output smaller than input")
+	}
+
+	for len(src) > 0 {
+		copy(x.tmp, src[:x.blockSize])
+		xor.InPlace(x.tmp, x.iv, xor.Equal)
+		x.b.Encrypt(dst[:x.blockSize], x.tmp)
+		copy(x.iv, dst[:x.blockSize])
+
+		src = src[x.blockSize:]
+		dst = dst[x.blockSize:]
+	}
+}
+
+type cbcDecrypter cbc
+
+//NewCBCDecrypter returns a BlockMode that decrypts with b in CBC mode starting from
+//iv. Successive calls to CryptBlocks carry on the chain.
+func NewCBCDecrypter(b cipher.Block, iv []byte) (cipher.BlockMode, error) {
+	x, err := newCBC(b, iv)
+	if err != nil {
+		return nil, err
+	}
+	return (*cbcDecrypter)(x), nil
+}
+
+func (x *cbcDecrypter) BlockSize() int {
+	return x.blockSize
+}
+
+func (x *cbcDecrypter) CryptBlocks(dst, src []byte) {
+	if len(src)%x.blockSize != 0 {
+		panic("crypto/cipher: input not full blocks")
+	}
+	if len(dst) < len(src) {
+		panic("crypto/cipher: output smaller than input")
+	}
+
+	for len(src) > 0 {
+		//the ciphertext block is kept aside, dst may overwrite it
+		copy(x.tmp, src[:x.blockSize])
+		x.b.Decrypt(dst[:x.blockSize], x.tmp)
+		xor.InPlace(dst[:x.blockSize], x.iv, xor.Equal)
+		x.iv, x.tmp = x.tmp, x.iv
+
+		src = src[x.blockSize:]
+		dst = dst[x.blockSize:]
+	}
+}
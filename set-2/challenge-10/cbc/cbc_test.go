@@ -0,0 +1,80 @@
+package cbc
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/des"
+	"math/rand"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	key := []byte("YELLOW SUBMARINE")
+	iv := make([]byte, 16)
+
+	for n := 0; n < 50; n++ {
+		pt := bytes.Repeat([]byte{'A'}, n)
+		ct, err := Encrypt(key, iv, pt)
+		if err != nil || len(ct) != (n/16+1)*16 {
+			t.Fatalf("%d bytes: got %d bytes of ciphertext (%v)", n, len(ct), err)
+		}
+		got, err := Decrypt(key, iv, ct)
+		if err != nil || !bytes.Equal(got, pt) {
+			t.Errorf("%d bytes: round trip gave %q (%v)", n, got, err)
+		}
+	}
+}
+
+//TestAnyBlock checks a block cipher other than AES, in place and over several calls,
+//against the standard library
+func TestAnyBlock(t *testing.T) {
+	block, _ := des.NewCipher([]byte("8bytekey"))
+	iv := []byte("initvect")
+	src := make([]byte, 8*40)
+	rand.New(rand.NewSource(1)).Read(src)
+
+	want := make([]byte, len(src))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(want, src)
+
+	got := append([]byte(nil), src...)
+	enc, err := NewCBCEncrypter(block, iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc.CryptBlocks(got[:64], got[:64])
+	enc.CryptBlocks(got[64:], got[64:])
+	if !bytes.Equal(got, want) {
+		t.Error("Encryption differs from crypto/cipher")
+	}
+
+	dec, _ := NewCBCDecrypter(block, iv)
+	dec.CryptBlocks(got[:8], got[:8])
+	dec.CryptBlocks(got[8:], got[8:])
+	if !bytes.Equal(got, src) {
+		t.Error("Decryption in place did not give the plaintext back")
+	}
+}
+
+func TestErrors(t *testing.T) {
+	block, _ := aes.NewCipher([]byte("YELLOW SUBMARINE"))
+	iv := make([]byte, 16)
+
+	if _, err := NewCBCEncrypter(block, iv[:8]); err != ErrIVSize {
+		t.Errorf("Expected ErrIVSize, got %v", err)
+	}
+	if _, err := Open(block, make([]byte, 17), make([]byte, 32)); err != ErrIVSize {
+		t.Errorf("Expected ErrIVSize, got %v", err)
+	}
+	if _, err := Open(block, iv, make([]byte, 20)); err != ErrNotFullBlocks {
+		t.Errorf("Expected ErrNotFullBlocks, got %v", err)
+	}
+
+	//flipping a bit of the IV flips the same bit of the first plaintext block
+	ct, _ := Seal(block, iv, []byte("ICE ICE BABY"))
+	bad := append([]byte(nil), iv...)
+	bad[15] ^= 1
+	if _, err := Open(block, bad, ct); err != ErrPadding {
+		t.Errorf("Expected ErrPadding after flipping a padding bit, got %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+package cbc
+
+import (
+	"crypto/aes"
+	"path/filepath"
+	"testing"
+
+	"cryptopals/set-1/challenge-07/cavp"
+)
+
+func crypt(encrypt bool) func(key, iv, in []byte) ([]byte, error) {
+	return func(key, iv, in []byte) ([]byte, error) {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		newMode := NewCBCDecrypter
+		if encrypt {
+			newMode = NewCBCEncrypter
+		}
+		mode, err := newMode(block, iv)
+		if err != nil {
+			return nil, err
+		}
+		if len(in)%mode.BlockSize() != 0 {
+			return nil, ErrNotFullBlocks
+		}
+		out := make([]byte, len(in))
+		mode.CryptBlocks(out, in)
+		return out, nil
+	}
+}
+
+func TestKAT(t *testing.T) {
+	//katgen's gen- files and any genuine NIST ones dropped next to them
+	files, _ := filepath.Glob("../../../set-1/challenge-07/cavp/testdata/*CBC*.rsp")
+	if len(files) == 0 {
+		t.Fatal("No CBC response files")
+	}
+
+	for _, name := range files {
+		vectors, err := cavp.ParseFile(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := cavp.Run(vectors, cavp.Mode{Encrypt: crypt(true), Decrypt: crypt(false)})
+		if len(r.Failures) > 0 {
+			t.Errorf("%s: %v", filepath.Base(name), r)
+		}
+	}
+}
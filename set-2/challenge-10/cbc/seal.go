@@ -0,0 +1,67 @@
+package cbc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+
+	"cryptopals/set-2/challenge-09/pkcs7"
+)
+
+//ErrNotFullBlocks is returned by Open for a ciphertext that is not a whole number of
+//blocks
+var ErrNotFullBlocks = errors.New("cbc: ciphertext is not a whole number of blocks")
+
+//ErrPadding is returned by Open when the plaintext does not end in valid PKCS#7
+//padding
+var ErrPadding = errors.New("cbc: invalid padding")
+
+//Seal pads plaintext with PKCS#7 and encrypts it with b in CBC mode from iv
+func Seal(b cipher.Block, iv, plaintext []byte) ([]byte, error) {
+	mode, err := NewCBCEncrypter(b, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	ct := pkcs7.Pad(plaintext, b.BlockSize())
+	mode.CryptBlocks(ct, ct)
+	return ct, nil
+}
+
+//Open reverses Seal
+func Open(b cipher.Block, iv, ciphertext []byte) ([]byte, error) {
+	mode, err := NewCBCDecrypter(b, iv)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) == 0 || len(ciphertext)%b.BlockSize() != 0 {
+		return nil, ErrNotFullBlocks
+	}
+
+	pt := make([]byte, len(ciphertext))
+	mode.CryptBlocks(pt, ciphertext)
+
+	pt, err = pkcs7.Unpad(pt, b.BlockSize())
+	if err != nil {
+		return nil, ErrPadding
+	}
+	return pt, nil
+}
+
+//Encrypt is Seal with AES under key, which must be 16, 24 or 32 bytes
+func Encrypt(key, iv, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return Seal(block, iv, plaintext)
+}
+
+//Decrypt is Open with AES under key
+func Decrypt(key, iv, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return Open(block, iv, ciphertext)
+}
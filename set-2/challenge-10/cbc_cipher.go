@@ -24,11 +24,11 @@ What's the point of even doing this stuff if you aren't going to learn from it?
 package main
 
 import (
-	"crypto/aes"
-	"encoding/base64"
-	"errors"
+	"flag"
 	"fmt"
-	"io/ioutil"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-2/challenge-10/cbc"
 )
 
 func main() {
@@ -37,84 +37,22 @@ func main() {
 	//iv needs to be the same length as an aes block size
 	iv := make([]byte, 16)
 
-	file, _ := ioutil.ReadFile("file.txt")
-
-	txt, _ := base64.StdEncoding.DecodeString(string(file))
-
-	fmt.Println(decryptAESCBC(key, txt, iv))
-}
-
-func decryptAESCBC(key []byte, cipherTxt, iv []byte) string {
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		panic("Cipher initializing failed")
+	flag.Parse()
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{"file.txt"}
 	}
 
-	var plaintxt []byte
-	prev := iv
-
-	for i := 0; i < len(cipherTxt); i += 16 {
-		temp := make([]byte, 16)
-		ci := cipherTxt[i : i+16]
-		if len(cipherTxt[i:i+16]) != 16 {
-			ci, _ = pkcs7Pad(cipherTxt[i:i+16], 16)
-		}
-
-		block.Decrypt(temp, ci)
-		plaintxt = append(plaintxt, xor(temp, prev)...)
-		prev = ci
-	}
-
-	return string(plaintxt)
-}
-
-func encryptAESCBC(key []byte, ptxt, iv []byte) string {
-	block, err := aes.NewCipher(key)
+	txt, err := input.LoadOne(names, input.Auto)
 	if err != nil {
-		panic("Cipher initializing failed")
-	}
-
-	ctxt := make([]byte, len(ptxt))
-	prev := iv
-
-	for i := 0; i < len(ptxt); i += 16 {
-		ci := ptxt[i : i+16]
-		if len(ptxt[i:i+16]) != 16 {
-			ci, _ = pkcs7Pad(ptxt[i:i+16], 16)
-		}
-		block.Encrypt(ctxt[i:i+16], xor(ci, prev))
-		prev = ctxt[i : i+16]
+		fmt.Println("Error: ", err)
+		return
 	}
 
-	return string(ctxt)
-}
-
-func xor(a, b []byte) []byte {
-	result := make([]byte, len(a))
-	for i := range a {
-		result[i] = a[i] ^ b[i]
-	}
-	return result
-}
-
-func pkcs7Pad(text []byte, blockToPad int) ([]byte, error) {
-	if len(text) > blockToPad {
-		return nil, errors.New("The text to pad is already larger than the specified number")
-	}
-
-	if blockToPad < 0 {
-		return nil, errors.New("Specified number to pad can not be negative")
-	}
-
-	if blockToPad > 256 {
-		return nil, errors.New("Out of specs")
-	}
-
-	toPad := blockToPad - len(text)
-
-	for i := 0; i < toPad; i++ {
-		text = append(text, byte(toPad))
+	plaintxt, err := cbc.Decrypt(key, iv, txt)
+	if err != nil {
+		fmt.Println("Error: ", err)
+		return
 	}
-
-	return text, nil
+	fmt.Println(string(plaintxt))
 }
@@ -24,12 +24,11 @@ or CBC, tells you which one is happening. */
 package main
 
 import (
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/rand"
 	"fmt"
 
-	"github.com/brsmsn/cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-2/challenge-10/cbc"
 )
 
 func main() {
@@ -61,42 +60,21 @@ func encrypt(pt, key []byte) []byte {
 	}
 
 	pt1 := append(pt, genRand()...)
-	newPt := pad(append(genRand(), pt1...))
-	ct := make([]byte, len(newPt))
+	newPt := append(genRand(), pt1...)
 
 	bytes := make([]byte, 1)
 	rand.Read(bytes)
 
-	block, _ := aes.NewCipher(key)
-
-	switch bytes[0] % 2 {
-	case 0:
-		mode := ecb.NewECBDecrypter(block)
-		mode.CryptBlocks(ct, newPt)
-	case 1:
-		//encrrypt with cbc
-		iv := make([]byte, 16)
-		rand.Read(iv)
-		mode := cipher.NewCBCEncrypter(block, iv)
-		mode.CryptBlocks(ct, newPt)
-	}
-	return ct
-}
-
-//pad text to a multiple of 16 bytes
-func pad(text []byte) []byte {
-	blocksToPad := 16 - len(text)%16
-
-	if blocksToPad == 0 || blocksToPad == 16 {
-		return text
-	}
-
-	padded := text
-	for i := 0; i < blocksToPad; i++ {
-		padded = append(padded, byte(blocksToPad))
+	if bytes[0]%2 == 0 {
+		ct, _ := ecb.Encrypt(key, newPt)
+		return ct
 	}
 
-	return padded
+	//encrrypt with cbc
+	iv := make([]byte, 16)
+	rand.Read(iv)
+	ct, _ := cbc.Encrypt(key, iv, newPt)
+	return ct
 }
 
 func detectMode(ct []byte) string {
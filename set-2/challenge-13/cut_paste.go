@@ -37,32 +37,37 @@ Using only the user input to profile_for() (as an oracle to generate "valid" cip
 package main
 
 import (
-	"crypto/aes"
-	"cryptopals/set-1/challenge-07/ecb"
 	"fmt"
 	"math/rand"
 	"strings"
+
+	"cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-2/challenge-09/pkcs7"
 )
 
 func main() {
 	key := genKey()
 
 	in := forgeToAdmin(key)
-	pt := string(dec(in, key))
-	fmt.Println(pt)
+	pt, err := dec(in, key)
+	if err != nil {
+		fmt.Println("Error: ", err)
+		return
+	}
+	fmt.Println(string(pt))
 }
 
 func forgeToAdmin(key []byte) []byte {
 	//We want to seperate the output into alligned blocks
-	n1 := pad([]byte("email=te@te.com"))
+	n1 := pkcs7.Pad([]byte("email=te@te.com"), 16)
 	in1 := string(n1[6:]) // removing the "email="
 
 	//needed to ensure we remain block alligned such that we have sufficient blocks to cut
-	n2 := pad([]byte("&uid=10&role="))
+	n2 := pkcs7.Pad([]byte("&uid=10&role="), 16)
 	in2 := string(n2[13:])
 
 	//very important this is what we will paste to the end
-	in3 := string(pad([]byte("admin")))
+	in3 := string(pkcs7.Pad([]byte("admin"), 16))
 
 	in := enc([]byte(profileFor(in1+in3+in2)), key)
 
@@ -90,52 +95,13 @@ func profileFor(payload string) string {
 	return "email=" + presafe2 + "&" + "uid=10" + "&" + "role=user"
 }
 
-func pad(text []byte) []byte {
-	blocksToPad := 16 - len(text)%16
-
-	if blocksToPad == 0 || blocksToPad == 16 {
-		return text
-	}
-
-	padded := text
-	for i := 0; i < blocksToPad; i++ {
-		padded = append(padded, byte(blocksToPad))
-	}
-
-	return padded
-}
-
-func unpad(text []byte) []byte {
-	pad := text[len(text)-1]
-
-	if int(pad) <= 16 {
-		indexPad := len(text) - int(pad) - 1
-		n := strings.Count(string(text[indexPad:len(text)]), string(pad))
-
-		if n == int(pad) {
-			return text[0 : indexPad+1]
-		}
-	}
-
-	return text
-}
-
 func enc(pt, key []byte) []byte {
-	newPt := pad(pt)
-	ct := make([]byte, len(newPt))
-
-	block, _ := aes.NewCipher(key)
-	mode := ecb.NewECBEncrypter(block)
-	mode.CryptBlocks(ct, newPt)
+	ct, _ := ecb.Encrypt(key, pt)
 	return ct
 }
 
-func dec(ct, key []byte) []byte {
-	pt := make([]byte, len(ct))
-	block, _ := aes.NewCipher(key)
-	mode := ecb.NewECBDecrypter(block)
-	mode.CryptBlocks(pt, ct)
-	return unpad(pt)
+func dec(ct, key []byte) ([]byte, error) {
+	return ecb.Decrypt(key, ct)
 }
 
 func genKey() []byte {
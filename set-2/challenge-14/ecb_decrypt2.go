@@ -14,13 +14,13 @@ Think "STIMULUS" and "RESPONSE".
 package main
 
 import (
-	"crypto/aes"
 	"crypto/rand"
 	"encoding/base64"
 	"fmt"
 	"reflect"
 
-	"github.com/brsmsn/cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-2/challenge-09/pkcs7"
 )
 
 const payload = `Um9sbGluJyBpbiBteSA1LjAKV2l0aCBteSByYWctdG9wIGRvd24gc28gbXkg
@@ -36,7 +36,7 @@ func main() {
 	unknown, _ := base64.StdEncoding.DecodeString(payload)
 
 	if mode == "ecb" {
-		for k := 0; k <= len(pad(unknown)); k += blocksize {
+		for k := 0; k <= len(pkcs7.Pad(unknown, 16)); k += blocksize {
 			for i := 1; i <= blocksize; i++ {
 				//build controlled input, each iteration is 1 byte short
 				inputblock := make([]byte, blocksize-i)
@@ -54,7 +54,7 @@ func main() {
 					str := []byte(dict[byte(j)])
 
 					//prevent from going out of bounds
-					if blocksize+k > len(pad(unknown)) {
+					if blocksize+k > len(pkcs7.Pad(unknown, 16)) {
 						break
 					}
 
@@ -88,31 +88,10 @@ func genKey() []byte {
 }
 
 func oracle(pt, key []byte) []byte {
-	newPt := pad(pt)
-	ct := make([]byte, len(newPt))
-
-	block, _ := aes.NewCipher(key)
-	mode := ecb.NewECBEncrypter(block)
-	mode.CryptBlocks(ct, newPt)
+	ct, _ := ecb.Encrypt(key, pt)
 	return ct
 }
 
-//pad text to a multiple of 16 bytes
-func pad(text []byte) []byte {
-	blocksToPad := 16 - len(text)%16
-
-	if blocksToPad == 0 || blocksToPad == 16 {
-		return text
-	}
-
-	padded := text
-	for i := 0; i < blocksToPad; i++ {
-		padded = append(padded, byte(blocksToPad))
-	}
-
-	return padded
-}
-
 func findBlocksize(key []byte) int {
 	var blocksize int
 	var curr int
@@ -0,0 +1,190 @@
+//Package ctr implements counter mode, which turns a block cipher into a stream cipher
+//by encrypting successive counter blocks and XORing the result with the data.
+//
+//The cryptopals format makes the counter block a 64-bit little-endian nonce followed
+//by a 64-bit little-endian count of the blocks before it. The standard format of SP
+//800-38A, the one of crypto/cipher, counts up from an IV as one big-endian integer the
+//size of the block. Either way the stream can seek to any byte offset, since every
+//keystream block only depends on its index.
+package ctr
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+//ErrBlockSize is returned by New for a block cipher whose blocks are not 16 bytes,
+//the cryptopals format has no room for anything else
+var ErrBlockSize = errors.New("ctr: the nonce and counter format needs 16 byte blocks")
+
+//ErrIVSize is returned by NewStandard for an IV that is not one block long
+var ErrIVSize = errors.New("ctr: IV length must equal the block size")
+
+//ErrOffset is returned by Edit for an offset outside the ciphertext
+var ErrOffset = errors.New("ctr: edit offset out of range")
+
+//ErrSeek is returned for a seek from the end, a stream has none, or to before the start
+var ErrSeek = errors.New("ctr: invalid seek")
+
+//Stream is a CTR keystream. It is a cipher.Stream and an io.Seeker over the
+//keystream.
+type Stream struct {
+	b cipher.Block
+	//counter fills in the counter block for a block index
+	counter func(block []byte, index uint64)
+
+	//pos is the offset of the next keystream byte
+	pos uint64
+	//ks is the keystream block at index ksIndex, when valid
+	ks      []byte
+	ksIndex uint64
+	valid   bool
+	//ctr is where counter blocks are built
+	ctr []byte
+}
+
+func newStream(b cipher.Block, counter func([]byte, uint64)) *Stream {
+	return &Stream{
+		b:       b,
+		counter: counter,
+		ks:      make([]byte, b.BlockSize()),
+		ctr:     make([]byte, b.BlockSize()),
+	}
+}
+
+//New returns the stream of b in the cryptopals format with the given nonce
+func New(b cipher.Block, nonce uint64) (*Stream, error) {
+	if b.BlockSize() != 16 {
+		return nil, ErrBlockSize
+	}
+
+	return newStream(b, func(block []byte, index uint64) {
+		binary.LittleEndian.PutUint64(block[:8], nonce)
+		binary.LittleEndian.PutUint64(block[8:], index)
+	}), nil
+}
+
+//NewStandard returns the stream of b counting up from iv as a big-endian integer,
+//wrapping around at the size of the block like crypto/cipher.NewCTR
+func NewStandard(b cipher.Block, iv []byte) (*Stream, error) {
+	if len(iv) != b.BlockSize() {
+		return nil, ErrIVSize
+	}
+	iv = append([]byte(nil), iv...)
+
+	return newStream(b, func(block []byte, index uint64) {
+		//add index to iv, byte by byte from the end
+		carry := index
+		for i := len(block) - 1; i >= 0; i-- {
+			sum := uint64(iv[i]) + carry&0xff
+			block[i] = byte(sum)
+			carry = carry>>8 + sum>>8
+		}
+	}), nil
+}
+
+//XORKeyStream XORs src with the keystream from the current offset into dst and moves
+//the offset past it. dst and src may overlap entirely or not at all.
+func (s *Stream) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("crypto/cipher: output smaller than input")
+	}
+
+	size := uint64(len(s.ks))
+	for len(src) > 0 {
+		index, off := s.pos/size, s.pos%size
+		if !s.valid || s.ksIndex != index {
+			s.counter(s.ctr, index)
+			s.b.Encrypt(s.ks, s.ctr)
+			s.ksIndex, s.valid = index, true
+		}
+
+		n := len(src)
+		if rest := int(size - off); n > rest {
+			n = rest
+		}
+		for i := 0; i < n; i++ {
+			dst[i] = src[i] ^ s.ks[int(off)+i]
+		}
+
+		s.pos += uint64(n)
+		src, dst = src[n:], dst[n:]
+	}
+}
+
+//Seek moves the keystream to offset from the start or the current offset. Seeking
+//from the end is an error, the keystream has none.
+func (s *Stream) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = int64(s.pos) + offset
+	default:
+		return int64(s.pos), ErrSeek
+	}
+	if pos < 0 {
+		return int64(s.pos), ErrSeek
+	}
+
+	s.pos = uint64(pos)
+	return pos, nil
+}
+
+//Crypt encrypts or decrypts data with AES under key in the cryptopals format
+func Crypt(key []byte, nonce uint64, data []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	s, err := New(block, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, len(data))
+	s.XORKeyStream(out, data)
+	return out, nil
+}
+
+//Edit returns a copy of ciphertext, which s encrypted from offset 0, with the
+//plaintext from offset on replaced by newtext. The ciphertext grows when newtext
+//runs past its end. Edit leaves s after the edit.
+func (s *Stream) Edit(ciphertext []byte, offset int, newtext []byte) ([]byte, error) {
+	if offset < 0 || offset > len(ciphertext) {
+		return nil, ErrOffset
+	}
+
+	size := len(ciphertext)
+	if end := offset + len(newtext); end > size {
+		size = end
+	}
+	out := make([]byte, size)
+	copy(out, ciphertext)
+
+	if _, err := s.Seek(int64(offset), io.SeekStart); err != nil {
+		return nil, err
+	}
+	s.XORKeyStream(out[offset:], newtext)
+	return out, nil
+}
+
+//Edit is the random access API of challenge 25: it rewrites the plaintext of a
+//ciphertext Crypt made under key with nonce 0, from offset on, without decrypting the
+//rest. Handing it out with the key bound gives the keystream away, Edit to zeros
+//returns it.
+func Edit(ciphertext, key []byte, offset int, newtext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	s, err := New(block, 0)
+	if err != nil {
+		return nil, err
+	}
+	return s.Edit(ciphertext, offset, newtext)
+}
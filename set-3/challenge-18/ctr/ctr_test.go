@@ -0,0 +1,125 @@
+package ctr
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"math/rand"
+	"testing"
+)
+
+func TestChallenge(t *testing.T) {
+	ctxt, _ := base64.StdEncoding.DecodeString("L77na/nrFsKvynd6HzOoG7GHTLXsTVu9qvY/2syLXzhPweyyMTJULu/6/kXX0KSvoOLSFQ==")
+	want := "Yo, VIP Let's kick it Ice, Ice, baby Ice, Ice, baby "
+
+	got, err := Crypt([]byte("YELLOW SUBMARINE"), 0, ctxt)
+	if err != nil || string(got) != want {
+		t.Errorf("Expected %q, got %q (%v)", want, got, err)
+	}
+}
+
+func TestSeek(t *testing.T) {
+	block, _ := aes.NewCipher([]byte("YELLOW SUBMARINE"))
+	whole, _ := New(block, 7)
+	ks := make([]byte, 1000)
+	whole.XORKeyStream(ks, ks)
+
+	rng := rand.New(rand.NewSource(1))
+	s, _ := New(block, 7)
+	for i := 0; i < 200; i++ {
+		off := rng.Intn(len(ks))
+		n := rng.Intn(len(ks) - off)
+		if _, err := s.Seek(int64(off), io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+
+		got := make([]byte, n)
+		s.XORKeyStream(got, got)
+		if !bytes.Equal(got, ks[off:off+n]) {
+			t.Fatalf("Keystream at %d differs after seeking", off)
+		}
+	}
+
+	if pos, err := s.Seek(-1, io.SeekCurrent); err != nil || pos < 0 {
+		t.Errorf("Expected a seek back, got %d (%v)", pos, err)
+	}
+	if _, err := s.Seek(0, io.SeekEnd); err != ErrSeek {
+		t.Errorf("Expected ErrSeek seeking from the end, got %v", err)
+	}
+	if _, err := s.Seek(-1, io.SeekStart); err != ErrSeek {
+		t.Errorf("Expected ErrSeek seeking before the start, got %v", err)
+	}
+}
+
+func TestStandard(t *testing.T) {
+	//SP 800-38A F.5.1, CTR-AES128.Encrypt
+	key, _ := hex.DecodeString("2b7e151628aed2a6abf7158809cf4f3c")
+	iv, _ := hex.DecodeString("f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff")
+	pt, _ := hex.DecodeString("6bc1bee22e409f96e93d7e117393172aae2d8a571e03ac9c9eb76fac45af8e51")
+	want := "874d6191b620e3261bef6864990db6ce9806f66b7970fdff8617187bb9fffdff"
+
+	block, _ := aes.NewCipher(key)
+	s, err := NewStandard(block, iv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]byte, len(pt))
+	s.XORKeyStream(got, pt)
+	if hex.EncodeToString(got) != want {
+		t.Errorf("Expected %s, got %x", want, got)
+	}
+
+	//the counter carries across bytes and wraps around like crypto/cipher's
+	for _, iv := range []string{"000000000000000000000000000000ff", "ffffffffffffffffffffffffffffffff", "00000000000000fffffffffffffffffe"} {
+		ivb, _ := hex.DecodeString(iv)
+		want := make([]byte, 16*300)
+		cipher.NewCTR(block, ivb).XORKeyStream(want, want)
+
+		s, _ := NewStandard(block, ivb)
+		got := make([]byte, len(want))
+		s.XORKeyStream(got[:5], got[:5])
+		s.XORKeyStream(got[5:], got[5:])
+		if !bytes.Equal(got, want) {
+			t.Errorf("IV %s: keystream differs from crypto/cipher", iv)
+		}
+	}
+
+	if _, err := NewStandard(block, iv[:8]); err != ErrIVSize {
+		t.Errorf("Expected ErrIVSize, got %v", err)
+	}
+}
+
+func TestEdit(t *testing.T) {
+	key := []byte("YELLOW SUBMARINE")
+	pt := []byte("Yo, VIP Let's kick it Ice, Ice, baby Ice, Ice, baby ")
+	ct, _ := Crypt(key, 0, pt)
+
+	tests := []struct {
+		offset  int
+		newtext string
+		want    string
+	}{
+		{0, "Hi", "Hi, VIP Let's kick it Ice, Ice, baby Ice, Ice, baby "},
+		{22, "Vanilla", "Yo, VIP Let's kick it Vanillae, baby Ice, Ice, baby "},
+		{50, "Ice, baby", "Yo, VIP Let's kick it Ice, Ice, baby Ice, Ice, babIce, baby"},
+		{len(pt), "!", string(pt) + "!"},
+	}
+
+	for _, tt := range tests {
+		edited, err := Edit(ct, key, tt.offset, []byte(tt.newtext))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _ := Crypt(key, 0, edited)
+		if string(got) != tt.want {
+			t.Errorf("Edit at %d: expected %q, got %q", tt.offset, tt.want, got)
+		}
+	}
+
+	if _, err := Edit(ct, key, len(ct)+1, []byte("x")); err != ErrOffset {
+		t.Errorf("Expected ErrOffset, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Implement CTR, the stream cipher mode
+The string:
+
+L77na/nrFsKvynd6HzOoG7GHTLXsTVu9qvY/2syLXzhPweyyMTJULu/6/kXX0KSvoOLSFQ==
+... decrypts to something approximating English in CTR mode, which is an AES block
+cipher mode that turns AES into a stream cipher, with the following parameters:
+
+      key=YELLOW SUBMARINE
+      nonce=0
+      format=64 bit unsigned little endian nonce,
+             64 bit little endian block count (byte count / 16)
+CTR mode is very simple.
+
+Instead of encrypting the plaintext, CTR mode encrypts a running counter,
+producing a 16 byte block of keystream, which is XOR'd against the plaintext.
+
+CTR mode does not require padding; when you run out of plaintext, you just stop
+XOR'ing keystream and stop generating keystream.
+
+Decryption is identical to encryption. Generate the same keystream, XOR, and
+recover the plaintext.
+
+Decrypt the string at the top of this function, then use your CTR function to
+encrypt and decrypt other things.
+*/
+
+package main
+
+import (
+	"encoding/base64"
+	"flag"
+	"fmt"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-3/challenge-18/ctr"
+)
+
+//Given is the ciphertext of the challenge
+const Given = "L77na/nrFsKvynd6HzOoG7GHTLXsTVu9qvY/2syLXzhPweyyMTJULu/6/kXX0KSvoOLSFQ=="
+
+func main() {
+	nonce := flag.Uint64("nonce", 0, "nonce of the keystream")
+	flag.Parse()
+
+	ctxt, _ := base64.StdEncoding.DecodeString(Given)
+	if flag.NArg() > 0 {
+		var err error
+		ctxt, err = input.LoadOne(flag.Args(), input.Auto)
+		if err != nil {
+			fmt.Println("Error: ", err)
+			return
+		}
+	}
+
+	ptxt, err := ctr.Crypt([]byte("YELLOW SUBMARINE"), *nonce, ctxt)
+	if err != nil {
+		fmt.Println("Error: ", err)
+		return
+	}
+	fmt.Println(string(ptxt))
+}
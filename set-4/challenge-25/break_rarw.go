@@ -0,0 +1,73 @@
+/*
+Break "random access read/write" AES CTR
+Back to CTR. Encrypt the recovered plaintext from this file (the ECB exercise) under
+CTR with a random key (for this exercise the key should be unknown to you, but hold
+on to it).
+
+Now, write the code that allows you to "seek" into the ciphertext, decrypt, and
+re-encrypt with different plaintext. Expose this as a function, like,
+"edit(ciphertext, key, offset, newtext)".
+
+Imagine the "edit" function was exposed to attackers by means of an API call that
+didn't reveal the key or the original plaintext; the attacker has the ciphertext and
+controls the offset and "new text".
+
+Recover the original plaintext.
+
+Food for thought.
+A folkloric supposed benefit of CTR mode is the ability to easily "seek forward" into
+the ciphertext; to access byte N of the ciphertext, all you need to be able to do is
+generate byte N of the keystream. Imagine if you'd relied on that advice to, say,
+encrypt a disk.
+*/
+
+package main
+
+import (
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"os"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-3/challenge-18/ctr"
+	"cryptopals/set-4/challenge-25/editattack"
+)
+
+func main() {
+	chunk := flag.Int("chunk", 0, "most bytes edited per oracle call, 0 for all at once")
+	flag.Parse()
+	names := flag.Args()
+	if len(names) == 0 {
+		names = []string{"../../set-1/challenge-07/file.txt"}
+	}
+
+	ecbCt, err := input.LoadOne(names, input.Auto)
+	checkErr(err)
+	pt, err := ecb.Decrypt([]byte("YELLOW SUBMARINE"), ecbCt)
+	checkErr(err)
+
+	//the key stays inside the oracle
+	key := make([]byte, 16)
+	_, err = rand.Read(key)
+	checkErr(err)
+	ct, err := ctr.Crypt(key, 0, pt)
+	checkErr(err)
+	oracle := editattack.OracleFunc(func(ciphertext []byte, offset int, newtext []byte) ([]byte, error) {
+		return ctr.Edit(ciphertext, key, offset, newtext)
+	})
+
+	res, err := editattack.Recover(oracle, ct, editattack.Options{Chunk: *chunk})
+	checkErr(err)
+
+	fmt.Printf("recovered %d bytes in %d oracle calls\n\n", len(res.Plaintext), res.Calls)
+	fmt.Print(string(res.Plaintext))
+}
+
+func checkErr(err error) {
+	if err != nil {
+		fmt.Println("Error: ", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,80 @@
+//Package editattack recovers the plaintext of a CTR ciphertext from an edit oracle,
+//the random access API of challenge 25. CTR XORs every byte with keystream that only
+//depends on its offset, so editing the plaintext to zeros makes the oracle return the
+//keystream itself, and the keystream XORed with the original ciphertext is the
+//plaintext.
+package editattack
+
+import (
+	"errors"
+	"fmt"
+)
+
+//Oracle edits ciphertexts under a key it keeps to itself: it returns ciphertext with
+//the plaintext from offset on replaced by newtext
+type Oracle interface {
+	Edit(ciphertext []byte, offset int, newtext []byte) ([]byte, error)
+}
+
+//OracleFunc adapts a function to the Oracle interface
+type OracleFunc func(ciphertext []byte, offset int, newtext []byte) ([]byte, error)
+
+//Edit calls f
+func (f OracleFunc) Edit(ciphertext []byte, offset int, newtext []byte) ([]byte, error) {
+	return f(ciphertext, offset, newtext)
+}
+
+//ErrShort is returned when the oracle gives back a ciphertext shorter than the edit
+var ErrShort = errors.New("editattack: the oracle returned a short ciphertext")
+
+//Options configures Recover
+type Options struct {
+	//Chunk is the most bytes edited per call, all of the ciphertext at once by default.
+	//Oracles that limit the size of an edit need less.
+	Chunk int
+}
+
+//Result is the outcome of Recover
+type Result struct {
+	Plaintext, Keystream []byte
+	//Calls is how many times the oracle was called
+	Calls int
+}
+
+//Recover asks o to edit ciphertext to zeros, chunk by chunk, and reads the keystream
+//off its answers
+func Recover(o Oracle, ciphertext []byte, opts Options) (*Result, error) {
+	chunk := opts.Chunk
+	if chunk <= 0 || chunk > len(ciphertext) {
+		chunk = len(ciphertext)
+	}
+
+	res := &Result{
+		Plaintext: make([]byte, len(ciphertext)),
+		Keystream: make([]byte, len(ciphertext)),
+	}
+	zeros := make([]byte, chunk)
+
+	for off := 0; off < len(ciphertext); off += chunk {
+		end := off + chunk
+		if end > len(ciphertext) {
+			end = len(ciphertext)
+		}
+
+		edited, err := o.Edit(ciphertext, off, zeros[:end-off])
+		res.Calls++
+		if err != nil {
+			return res, fmt.Errorf("editattack: edit at %d: %w", off, err)
+		}
+		if len(edited) < end {
+			return res, ErrShort
+		}
+
+		copy(res.Keystream[off:end], edited[off:end])
+	}
+
+	for i, c := range ciphertext {
+		res.Plaintext[i] = c ^ res.Keystream[i]
+	}
+	return res, nil
+}
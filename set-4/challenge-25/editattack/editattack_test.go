@@ -0,0 +1,44 @@
+package editattack
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"cryptopals/set-1/challenge-01/input"
+	"cryptopals/set-1/challenge-07/ecb"
+	"cryptopals/set-3/challenge-18/ctr"
+)
+
+func TestRecover(t *testing.T) {
+	ecbCt, err := input.LoadOne([]string{"../../../set-1/challenge-07/file.txt"}, input.Base64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pt, err := ecb.Decrypt([]byte("YELLOW SUBMARINE"), ecbCt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := make([]byte, 16)
+	rand.Read(key)
+	ct, _ := ctr.Crypt(key, 0, pt)
+	oracle := OracleFunc(func(ciphertext []byte, offset int, newtext []byte) ([]byte, error) {
+		return ctr.Edit(ciphertext, key, offset, newtext)
+	})
+
+	for _, tt := range []struct{ chunk, calls int }{
+		{0, 1},
+		{1000, (len(ct) + 999) / 1000},
+	} {
+		res, err := Recover(oracle, ct, Options{Chunk: tt.chunk})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(res.Plaintext) != string(pt) {
+			t.Errorf("Chunk %d: plaintext not recovered", tt.chunk)
+		}
+		if res.Calls != tt.calls {
+			t.Errorf("Chunk %d: expected %d calls, got %d", tt.chunk, tt.calls, res.Calls)
+		}
+	}
+}